@@ -0,0 +1,93 @@
+package paddle
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// AlertDelivery is a past webhook delivery attempt.
+type AlertDelivery struct {
+	ID        int                        `json:"id"`
+	AlertName string                     `json:"alert_name"`
+	Status    string                     `json:"status"`
+	CreatedAt string                     `json:"created_at"`
+	UpdatedAt string                     `json:"updated_at"`
+	Attempts  int                        `json:"attempts"`
+	Fields    map[string]json.RawMessage `json:"fields"`
+}
+
+// Decode decodes the fields of the alert the same way ValidatePayload does,
+// e.g. into a *SubscriptionCreated. It returns nil for unknown alerts.
+func (d *AlertDelivery) Decode() (interface{}, error) {
+	payload := make(map[string]string, len(d.Fields))
+	for k, v := range d.Fields {
+		switch {
+		case len(v) > 0 && v[0] == '"':
+			var str string
+			if err := json.Unmarshal(v, &str); err != nil {
+				return nil, err
+			}
+			payload[k] = str
+		case string(v) == "null":
+			payload[k] = ""
+		default:
+			// Numbers are copied verbatim, so that large IDs don't go
+			// through a float64.
+			payload[k] = string(v)
+		}
+	}
+
+	return DecodeAlert(d.AlertName, payload)
+}
+
+type AlertHistory struct {
+	CurrentPage   int             `json:"current_page"`
+	TotalPages    int             `json:"total_pages"`
+	AlertsPerPage int             `json:"alerts_per_page"`
+	TotalAlerts   int             `json:"total_alerts"`
+	QueryHead     string          `json:"query_head"`
+	QueryTail     string          `json:"query_tail"`
+	Data          []AlertDelivery `json:"data"`
+}
+
+type AlertHistoryResponse struct {
+	Success  bool         `json:"success"`
+	Response AlertHistory `json:"response"`
+}
+
+// https://developer.paddle.com/api-reference/alert-api/webhooks/getwebhookhistory
+type AlertHistoryOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	Page          int    `url:"page,omitempty"`
+	AlertsPerPage int    `url:"alerts_per_page,omitempty"`
+	QueryHead     string `url:"query_head,omitempty"` // end date
+	QueryTail     string `url:"query_tail,omitempty"` // start date
+}
+
+// History lists the webhooks Paddle sent between from and to, newest first.
+// Either may be zero to leave that end open. Pages start at 1.
+func (s *AlertService) History(ctx context.Context, from, to time.Time, page int, opts ...RequestOption) (*AlertHistoryResponse, error) {
+	options := &AlertHistoryOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+		Page:           page,
+	}
+	if !from.IsZero() {
+		options.QueryTail = from.UTC().Format("2006-01-02 15:04:05")
+	}
+	if !to.IsZero() {
+		options.QueryHead = to.UTC().Format("2006-01-02 15:04:05")
+	}
+	req, err := s.client.NewFormRequest("alert/webhooks", options)
+	if err != nil {
+		return nil, err
+	}
+
+	history := new(AlertHistoryResponse)
+	_, err = s.client.Do(ctx, req, history, safeToRetry(opts)...)
+
+	return history, err
+}
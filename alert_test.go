@@ -0,0 +1,43 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertHistory(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/alert/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "2021-05-01 00:00:00", r.PostForm.Get("query_tail"))
+		require.Equal(t, "2021-05-02 00:00:00", r.PostForm.Get("query_head"))
+		require.Equal(t, "1", r.PostForm.Get("page"))
+		fmt.Fprint(w, `{"success":true,"response":{"current_page":1,"total_pages":1,"alerts_per_page":10,"total_alerts":2,"query_tail":"2021-05-01 00:00:00","data":[
+			{"id":1,"alert_name":"subscription_cancelled","status":"failed","created_at":"2021-05-01 10:00:00","updated_at":"2021-05-01 11:00:00","attempts":3,"fields":{"subscription_id":4567890,"user_id":12345678901,"cancellation_effective_date":"2021-05-12","passthrough":null}},
+			{"id":2,"alert_name":"high_risk_transaction_created","status":"success","created_at":"2021-05-01 12:00:00","updated_at":"2021-05-01 12:00:00","attempts":1,"fields":{}}
+		]}}`)
+	})
+
+	res, err := client.Alert.History(context.Background(),
+		time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 5, 2, 0, 0, 0, 0, time.UTC), 1)
+	require.NoError(t, err)
+	require.Len(t, res.Response.Data, 2)
+	require.Equal(t, 3, res.Response.Data[0].Attempts)
+
+	alert, err := res.Response.Data[0].Decode()
+	require.NoError(t, err)
+	cancelled, ok := alert.(*SubscriptionCancelled)
+	require.True(t, ok)
+	require.Equal(t, "4567890", cancelled.SubscriptionID)
+	require.Equal(t, "12345678901", cancelled.UserID)
+	require.Equal(t, 12, cancelled.GetCancellationEffectiveDate().Day())
+
+	alert, err = res.Response.Data[1].Decode()
+	require.NoError(t, err)
+	require.Nil(t, alert)
+}
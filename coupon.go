@@ -0,0 +1,172 @@
+package paddle
+
+import (
+	"context"
+)
+
+type Coupon struct {
+	Coupon           string  `json:"coupon"`
+	Description      string  `json:"description"`
+	DiscountType     string  `json:"discount_type"` // flat or percentage
+	DiscountAmount   float64 `json:"discount_amount"`
+	DiscountCurrency string  `json:"discount_currency"`
+	AllowedUses      int     `json:"allowed_uses"`
+	TimesUsed        int     `json:"times_used"`
+	IsRecurring      bool    `json:"is_recurring"`
+	Expires          string  `json:"expires"`
+}
+
+// https://developer.paddle.com/api-reference/product-api/coupons/listcoupons
+type CouponListOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	ProductID int `url:"product_id"`
+}
+
+type CouponListResponse struct {
+	Success  bool     `json:"success"`
+	Response []Coupon `json:"response"`
+}
+
+// List lists the coupons valid for the product.
+func (s *CouponService) List(ctx context.Context, productID int, opts ...RequestOption) (*CouponListResponse, error) {
+	options := &CouponListOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+		ProductID:      productID,
+	}
+	req, err := s.client.NewFormRequest("product/list_coupons", options)
+	if err != nil {
+		return nil, err
+	}
+
+	coupons := new(CouponListResponse)
+	_, err = s.client.Do(ctx, req, coupons, safeToRetry(opts)...)
+
+	return coupons, err
+}
+
+// https://developer.paddle.com/api-reference/product-api/coupons/createcoupon
+//
+// Set CouponCode to create a single coupon, or CouponPrefix and NumCoupons to
+// create many at once.
+type CouponCreateOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	CouponType       string  `url:"coupon_type,omitempty"`     // required: product or checkout
+	DiscountType     string  `url:"discount_type,omitempty"`   // required: flat or percentage
+	DiscountAmount   float64 `url:"discount_amount,omitempty"` // required
+	Currency         string  `url:"currency,omitempty"`        // required for flat discounts
+	ProductIDs       []int   `url:"product_ids,comma,omitempty"`
+	CouponCode       string  `url:"coupon_code,omitempty"`
+	CouponPrefix     string  `url:"coupon_prefix,omitempty"`
+	NumCoupons       int     `url:"num_coupons,omitempty"`
+	Description      string  `url:"description,omitempty"`
+	AllowedUses      int     `url:"allowed_uses,omitempty"`
+	Expires          string  `url:"expires,omitempty"` // YYYY-MM-DD
+	Recurring        *bool   `url:"recurring,omitempty,int"`
+	Group            string  `url:"group,omitempty"`
+	MinimumThreshold float64 `url:"minimum_threshold,omitempty"`
+}
+
+type CouponCreate struct {
+	CouponCodes []string `json:"coupon_codes"`
+}
+
+type CouponCreateResponse struct {
+	Success  bool         `json:"success"`
+	Response CouponCreate `json:"response"`
+}
+
+func (s *CouponService) Create(ctx context.Context, options *CouponCreateOptions, opts ...RequestOption) (*CouponCreateResponse, error) {
+	options.VendorID = s.client.conf.VendorID
+	options.VendorAuthCode = s.client.conf.APIKey
+	// This one is only available in version 2.1 of the API.
+	req, err := s.client.NewFormRequest("../2.1/product/create_coupon", options)
+	if err != nil {
+		return nil, err
+	}
+
+	coupons := new(CouponCreateResponse)
+	_, err = s.client.Do(ctx, req, coupons, withEndpoint(opts, "product/create_coupon")...)
+
+	return coupons, err
+}
+
+// https://developer.paddle.com/api-reference/product-api/coupons/updatecoupon
+//
+// Set either CouponCode or Group to select the coupons to update.
+type CouponUpdateOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	CouponCode     string  `url:"coupon_code,omitempty"`
+	Group          string  `url:"group,omitempty"`
+	NewCouponCode  string  `url:"new_coupon_code,omitempty"`
+	NewGroup       string  `url:"new_group,omitempty"`
+	ProductIDs     []int   `url:"product_ids,comma,omitempty"`
+	Expires        string  `url:"expires,omitempty"` // YYYY-MM-DD
+	AllowedUses    int     `url:"allowed_uses,omitempty"`
+	Currency       string  `url:"currency,omitempty"`
+	DiscountAmount float64 `url:"discount_amount,omitempty"`
+	Recurring      *bool   `url:"recurring,omitempty,int"`
+}
+
+type CouponUpdate struct {
+	// Updated is the number of coupons updated.
+	Updated int `json:"updated"`
+}
+
+type CouponUpdateResponse struct {
+	Success  bool         `json:"success"`
+	Response CouponUpdate `json:"response"`
+}
+
+func (s *CouponService) Update(ctx context.Context, options *CouponUpdateOptions, opts ...RequestOption) (*CouponUpdateResponse, error) {
+	options.VendorID = s.client.conf.VendorID
+	options.VendorAuthCode = s.client.conf.APIKey
+	// This one is only available in version 2.1 of the API.
+	req, err := s.client.NewFormRequest("../2.1/product/update_coupon", options)
+	if err != nil {
+		return nil, err
+	}
+
+	update := new(CouponUpdateResponse)
+	_, err = s.client.Do(ctx, req, update, withEndpoint(opts, "product/update_coupon")...)
+
+	return update, err
+}
+
+// https://developer.paddle.com/api-reference/product-api/coupons/deletecoupon
+type CouponDeleteOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	CouponCode string `url:"coupon_code"`
+	ProductID  int    `url:"product_id,omitempty"`
+}
+
+type CouponDeleteResponse struct {
+	Success bool `json:"success"`
+}
+
+// Delete deletes the coupon. productID is only needed for product coupons.
+func (s *CouponService) Delete(ctx context.Context, couponCode string, productID int, opts ...RequestOption) (*CouponDeleteResponse, error) {
+	options := &CouponDeleteOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+		CouponCode:     couponCode,
+		ProductID:      productID,
+	}
+	req, err := s.client.NewFormRequest("product/delete_coupon", options)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion := new(CouponDeleteResponse)
+	_, err = s.client.Do(ctx, req, deletion, opts...)
+
+	return deletion, err
+}
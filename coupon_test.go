@@ -0,0 +1,82 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoupons(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/product/list_coupons", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "5", r.PostForm.Get("product_id"))
+		fmt.Fprint(w, `{"success":true,"response":[{"coupon":"SUMMER","description":"Summer sale","discount_type":"percentage","discount_amount":0.2,"discount_currency":"USD","allowed_uses":100,"times_used":3,"is_recurring":false,"expires":"2021-09-01 00:00:00"}]}`)
+	})
+	mux.HandleFunc("/2.1/product/create_coupon", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "product", r.PostForm.Get("coupon_type"))
+		require.Equal(t, "5,6", r.PostForm.Get("product_ids"))
+		require.Equal(t, "SUMMER", r.PostForm.Get("coupon_prefix"))
+		require.Equal(t, "2", r.PostForm.Get("num_coupons"))
+		require.Equal(t, "1", r.PostForm.Get("recurring"))
+		fmt.Fprint(w, `{"success":true,"response":{"coupon_codes":["SUMMER-1","SUMMER-2"]}}`)
+	})
+	mux.HandleFunc("/2.1/product/update_coupon", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "summer", r.PostForm.Get("group"))
+		require.Equal(t, "2021-10-01", r.PostForm.Get("expires"))
+		fmt.Fprint(w, `{"success":true,"response":{"updated":2}}`)
+	})
+	mux.HandleFunc("/product/delete_coupon", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "SUMMER-1", r.PostForm.Get("coupon_code"))
+		require.Equal(t, "5", r.PostForm.Get("product_id"))
+		fmt.Fprint(w, `{"success":true}`)
+	})
+
+	coupons, err := client.Coupon.List(context.Background(), 5)
+	require.NoError(t, err)
+	require.Equal(t, []Coupon{{
+		Coupon:           "SUMMER",
+		Description:      "Summer sale",
+		DiscountType:     "percentage",
+		DiscountAmount:   0.2,
+		DiscountCurrency: "USD",
+		AllowedUses:      100,
+		TimesUsed:        3,
+		Expires:          "2021-09-01 00:00:00",
+	}}, coupons.Response)
+
+	recurring := true
+	created, err := client.Coupon.Create(context.Background(), &CouponCreateOptions{
+		CouponType:     "product",
+		DiscountType:   "percentage",
+		DiscountAmount: 20,
+		ProductIDs:     []int{5, 6},
+		CouponPrefix:   "SUMMER",
+		NumCoupons:     2,
+		Recurring:      &recurring,
+		Group:          "summer",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"SUMMER-1", "SUMMER-2"}, created.Response.CouponCodes)
+
+	updated, err := client.Coupon.Update(context.Background(), &CouponUpdateOptions{Group: "summer", Expires: "2021-10-01"})
+	require.NoError(t, err)
+	require.Equal(t, 2, updated.Response.Updated)
+
+	deleted, err := client.Coupon.Delete(context.Background(), "SUMMER-1", 5)
+	require.NoError(t, err)
+	require.True(t, deleted.Success)
+}
+
+func TestCouponAPIVersion(t *testing.T) {
+	client := (&Conf{}).NewClient(context.Background(), nil)
+	req, err := client.NewFormRequest("../2.1/product/create_coupon", nil)
+	require.NoError(t, err)
+	require.Equal(t, "https://vendors.paddle.com/api/2.1/product/create_coupon", req.URL.String())
+}
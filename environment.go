@@ -0,0 +1,68 @@
+package paddle
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Environment selects the Paddle hosts a Client talks to. Paddle has two
+// separate APIs, the vendors API and the checkout API, and an environment
+// always switches both of them. Use Production or Sandbox, or set both URLs
+// to talk to something else, e.g. a test server. The URLs must have a
+// trailing slash. The zero value is Production; setting only one of the URLs
+// is an error.
+type Environment struct {
+	VendorsURL  string
+	CheckoutURL string
+}
+
+var (
+	Production = Environment{
+		VendorsURL:  "https://vendors.paddle.com/api/2.0/",
+		CheckoutURL: "https://checkout.paddle.com/api/2.0/",
+	}
+	Sandbox = Environment{
+		VendorsURL:  "https://sandbox-vendors.paddle.com/api/2.0/",
+		CheckoutURL: "https://sandbox-checkout.paddle.com/api/2.0/",
+	}
+)
+
+// Validate reports whether the environment is usable, e.g. to check
+// deployment configuration before calling Conf.NewClient, which panics on an
+// invalid environment.
+func (e Environment) Validate() error {
+	_, _, err := e.baseURLs()
+	return err
+}
+
+// baseURLs returns the base URLs of the vendors and the checkout API.
+func (e Environment) baseURLs() (vendors, checkout *url.URL, err error) {
+	switch {
+	case e.VendorsURL == "" && e.CheckoutURL == "":
+		e = Production
+	case e.VendorsURL == "" || e.CheckoutURL == "":
+		return nil, nil, fmt.Errorf("paddle: Environment must set both VendorsURL and CheckoutURL, got %q and %q", e.VendorsURL, e.CheckoutURL)
+	}
+
+	if vendors, err = parseBaseURL("VendorsURL", e.VendorsURL); err != nil {
+		return nil, nil, err
+	}
+	if checkout, err = parseBaseURL("CheckoutURL", e.CheckoutURL); err != nil {
+		return nil, nil, err
+	}
+
+	return vendors, checkout, nil
+}
+
+func parseBaseURL(name, s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("paddle: invalid %v: %w", name, err)
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		return nil, fmt.Errorf("paddle: %v must have a trailing slash, but %q does not", name, s)
+	}
+
+	return u, nil
+}
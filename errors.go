@@ -0,0 +1,131 @@
+package paddle
+
+import (
+	"errors"
+	"strings"
+)
+
+// Errors returned by the Paddle API. The *ErrorResponse returned by the
+// services unwraps to those matching its code and message, so use errors.Is
+// to check for them:
+//
+//	if errors.Is(err, paddle.ErrSubscriptionNotFound) {
+//		...
+//	}
+//
+// https://developer.paddle.com/api-reference/intro/api-error-codes
+var (
+	ErrLicenseNotFound            = errors.New("Unable to find requested license")
+	ErrBadMethodCall              = errors.New("Bad method call")
+	ErrBadAPIKey                  = errors.New("Bad api key")
+	ErrInvalidTimestamp           = errors.New("Timestamp is too old or not valid")
+	ErrLicenseUtilized            = errors.New("License code has already been utilized")
+	ErrLicenseNotActive           = errors.New("License code is not active")
+	ErrActivationNotFound         = errors.New("Unable to find requested activation")
+	ErrPermissionDenied           = errors.New("You don't have permission to access this resource")
+	ErrProductNotFound            = errors.New("Unable to find requested product")
+	ErrInvalidCurrency            = errors.New("Provided currency is not valid")
+	ErrPurchaseNotFound           = errors.New("Unable to find requested purchase")
+	ErrInvalidAuthToken           = errors.New("Invalid authentication token")
+	ErrInvalidVerificationToken   = errors.New("Invalid verification token")
+	ErrInvalidPadding             = errors.New("Invalid padding on decrypted string")
+	ErrInvalidAffiliate           = errors.New("Invalid or duplicated affiliate")
+	ErrInvalidAffiliateCommission = errors.New("Invalid or missing affiliate commission")
+	ErrMissingArguments           = errors.New("One or more required arguments are missing")
+	ErrInvalidExpiration          = errors.New("Provided expiration time is incorrect")
+	ErrInvalidPrice               = errors.New("Provided price is incorrect")
+	ErrSubscriptionNotFound       = errors.New("Unable to find requested subscription")
+
+	// Subscription plans are products in Paddle, so an unknown plan is
+	// reported as ErrProductNotFound.
+	ErrPlanNotFound = ErrProductNotFound
+
+	// Paddle does not send distinct codes for these, nor does it document
+	// their messages. They are matched on keywords instead, see
+	// errorMessages.
+	ErrCountryDoesNotExist          = errors.New("Country does not exist")
+	ErrSubscriptionAlreadyCancelled = errors.New("The subscription has already been cancelled")
+	ErrAlreadyRefunded              = errors.New("The order has already been fully refunded")
+	ErrRefundAmountTooHigh          = errors.New("The refund amount is higher than the amount available to refund")
+	ErrUserNotFound                 = errors.New("We were unable to find a user with that email address.")
+)
+
+var errorCodes = map[int]error{
+	100: ErrLicenseNotFound,
+	101: ErrBadMethodCall,
+	102: ErrBadAPIKey,
+	103: ErrInvalidTimestamp,
+	104: ErrLicenseUtilized,
+	105: ErrLicenseNotActive,
+	106: ErrActivationNotFound,
+	107: ErrPermissionDenied,
+	108: ErrProductNotFound,
+	109: ErrInvalidCurrency,
+	110: ErrPurchaseNotFound,
+	111: ErrInvalidAuthToken,
+	112: ErrInvalidVerificationToken,
+	113: ErrInvalidPadding,
+	114: ErrInvalidAffiliate,
+	115: ErrInvalidAffiliateCommission,
+	116: ErrMissingArguments,
+	117: ErrInvalidExpiration,
+	118: ErrInvalidPrice,
+	119: ErrSubscriptionNotFound,
+}
+
+// messageMatcher matches an error message from the endpoint which contains
+// all of the keywords, ignoring case. A keyword may list alternatives
+// separated by "|". An empty endpoint matches all of them.
+type messageMatcher struct {
+	err      error
+	endpoint string
+	keywords []string
+}
+
+func (m messageMatcher) match(endpoint, message string) bool {
+	if m.endpoint != "" && m.endpoint != endpoint {
+		return false
+	}
+
+	message = strings.ToLower(message)
+	for _, keyword := range m.keywords {
+		found := false
+		for _, alt := range strings.Split(keyword, "|") {
+			if strings.Contains(message, alt) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// errorMessages complements errorCodes, for errors which Paddle does not give
+// a distinct code. The exact wording isn't documented, so only the keywords
+// are matched, and only in the responses of the endpoint the error belongs
+// to.
+var errorMessages = []messageMatcher{
+	{ErrCountryDoesNotExist, "", []string{"country", "not exist|doesn't exist|not found|not valid|invalid"}},
+	{ErrSubscriptionAlreadyCancelled, "subscription/users_cancel", []string{"already", "cancelled|canceled"}},
+	{ErrAlreadyRefunded, "payment/refund", []string{"refunded", "already|has been fully|was fully|is fully"}},
+	{ErrRefundAmountTooHigh, "payment/refund", []string{"refund", "amount", "higher|greater|exceed|more than|too high|too large|too much"}},
+	{ErrUserNotFound, "user/history", []string{"user|email", "unable to find|could not find|couldn't find|not found|no user|not exist|doesn't exist|unknown"}},
+}
+
+// IsAuthError reports whether err is caused by bad vendor credentials.
+func IsAuthError(err error) bool {
+	// Paddle reuses the codes of auth errors for other errors, e.g. 107 for
+	// an unknown email in user/history, which match a more specific
+	// sentinel by their message.
+	var eresp *ErrorResponse
+	if errors.As(err, &eresp) && len(eresp.messageErrors()) > 0 {
+		return false
+	}
+
+	return errors.Is(err, ErrBadAPIKey) || errors.Is(err, ErrPermissionDenied) ||
+		errors.Is(err, ErrInvalidAuthToken)
+}
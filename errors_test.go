@@ -0,0 +1,100 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponse(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":false,"error":{"code":119,"message":"Unable to find requested subscription"}}`)
+	})
+	mux.HandleFunc("/checkout/prices", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":false,"error":{"message":"Country does not exist"}}`)
+	})
+	mux.HandleFunc("/product/generate_pay_link", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":false,"error":{"code":102,"message":"Bad api key"}}`)
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	require.ErrorIs(t, err, ErrSubscriptionNotFound)
+	var eresp *ErrorResponse
+	require.True(t, errors.As(err, &eresp))
+	require.Equal(t, 119, eresp.ErrorField.Code)
+
+	_, err = client.Subscription.Prices(context.Background(), SubscriptionPricesOptions{})
+	require.ErrorIs(t, err, ErrCountryDoesNotExist)
+
+	_, err = client.Product.GeneratePayLink(context.Background(), &ProductGeneratePayLinkOptions{})
+	require.True(t, IsAuthError(err))
+	require.False(t, IsAuthError(ErrSubscriptionNotFound))
+}
+
+func TestErrorMessages(t *testing.T) {
+	// Paddle doesn't document these messages, so spelling and wording
+	// variants must all match, and similar messages, or messages from other
+	// endpoints, must not.
+	tests := []struct {
+		endpoint string
+		message  string
+		want     error
+	}{
+		{"prices", "Country does not exist", ErrCountryDoesNotExist},
+		{"prices", "The country code is not valid.", ErrCountryDoesNotExist},
+		{"subscription/users_cancel", "The subscription has already been cancelled", ErrSubscriptionAlreadyCancelled},
+		{"subscription/users_cancel", "Subscription is already canceled.", ErrSubscriptionAlreadyCancelled},
+		{"subscription/users_cancel", "This subscription has ALREADY been Cancelled", ErrSubscriptionAlreadyCancelled},
+		{"subscription/users_cancel", "Unable to find requested subscription", nil},
+		{"subscription/users_cancel", "The subscription cannot be cancelled", nil},
+		{"subscription/users/update", "The subscription has already been cancelled", nil},
+		{"payment/refund", "The order has already been fully refunded", ErrAlreadyRefunded},
+		{"payment/refund", "Order already refunded.", ErrAlreadyRefunded},
+		{"payment/refund", "This order has been fully refunded", ErrAlreadyRefunded},
+		{"payment/refund", "The refund amount is higher than the amount available to refund", ErrRefundAmountTooHigh},
+		{"payment/refund", "Refund amount exceeds the refundable amount", ErrRefundAmountTooHigh},
+		{"payment/refund", "The amount to refund is greater than the order total.", ErrRefundAmountTooHigh},
+		{"payment/refund", "The order has been partially refunded", nil},
+		{"payment/refund", "Refund amount must be a number", nil},
+		{"payment/refund", "The order cannot be fully refunded", nil},
+		{"product/generate_license", "The order has already been fully refunded", nil},
+		{"subscription/users/update", "The refund amount is higher than the amount available to refund", nil},
+		{"user/history", "We were unable to find a user with that email address.", ErrUserNotFound},
+		{"user/history", "User not found", ErrUserNotFound},
+		{"user/history", "No user exists with this email", ErrUserNotFound},
+		{"user/history", "The email address is not valid", nil},
+		{"product/generate_license", "Unable to find requested license for this email", nil},
+	}
+	for _, tt := range tests {
+		err := &ErrorResponse{endpoint: tt.endpoint, ErrorField: Error{Message: tt.message}}
+		if tt.want == nil {
+			require.Empty(t, err.Unwrap(), tt.message)
+		} else {
+			require.Equal(t, []error{tt.want}, err.Unwrap(), tt.message)
+		}
+	}
+}
+
+func TestErrorResponseUnwrap(t *testing.T) {
+	// Both the code and the message are matched.
+	err := &ErrorResponse{endpoint: "user/history", ErrorField: Error{Code: 107, Message: "We were unable to find a user with that email address."}}
+	require.ErrorIs(t, err, ErrPermissionDenied)
+	require.ErrorIs(t, err, ErrUserNotFound)
+	// A customer's typo isn't a problem with our credentials.
+	require.False(t, IsAuthError(err))
+
+	err = &ErrorResponse{endpoint: "subscription/users", ErrorField: Error{Code: 107, Message: "You don't have permission to access this resource"}}
+	require.True(t, IsAuthError(err))
+
+	err = &ErrorResponse{endpoint: "subscription/users_cancel", ErrorField: Error{Code: 119, Message: "The subscription has already been cancelled"}}
+	require.ErrorIs(t, err, ErrSubscriptionNotFound)
+	require.ErrorIs(t, err, ErrSubscriptionAlreadyCancelled)
+
+	err = &ErrorResponse{ErrorField: Error{Code: 999, Message: "Something else"}}
+	require.Empty(t, err.Unwrap())
+}
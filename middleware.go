@@ -0,0 +1,159 @@
+package paddle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Middleware observes the traffic of a Client, see Conf.Middleware. Any of the
+// hooks may be nil.
+type Middleware struct {
+	// BeforeRequest is called before every attempt, retries included. It may
+	// modify the request, e.g. add headers, but it must not consume the body;
+	// use req.GetBody to read it.
+	BeforeRequest func(req *http.Request)
+
+	// AfterResponse is called with every response and its body, whether or
+	// not Paddle reported an error.
+	AfterResponse func(req *http.Request, resp *http.Response, body []byte, elapsed time.Duration)
+
+	// OnError is called once per call if Client.Do fails. resp is nil if no
+	// response was received.
+	OnError func(req *http.Request, resp *http.Response, err error)
+}
+
+// redacted replaces the values of sensitive fields.
+const redacted = "REDACTED"
+
+// redactedFields are matched as substrings of lower cased field names.
+var redactedFields = []string{
+	"vendor_auth_code",
+	"email",
+	"card",
+	"last_four_digits",
+	"expiry_date",
+}
+
+func isRedacted(field string) bool {
+	field = strings.ToLower(field)
+	for _, f := range redactedFields {
+		if strings.Contains(field, f) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactForm returns the form encoded values with sensitive fields redacted.
+func redactForm(values url.Values) string {
+	ret := url.Values{}
+	for k, v := range values {
+		if isRedacted(k) {
+			ret[k] = []string{redacted}
+		} else {
+			ret[k] = v
+		}
+	}
+
+	return ret.Encode()
+}
+
+// redactJSON returns the JSON document with sensitive fields redacted. Bodies
+// which aren't JSON are not returned at all, as there is no telling what's in
+// them.
+func redactJSON(data []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON data>", len(data))
+	}
+
+	j, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes of data>", len(data))
+	}
+
+	return string(j)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if isRedacted(k) {
+				v[k] = redacted
+			} else {
+				v[k] = redactValue(val)
+			}
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactValue(val)
+		}
+	}
+
+	return v
+}
+
+// requestBody returns the redacted form encoded body of req, without
+// consuming it.
+func requestBody(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+	values, err := url.ParseQuery(string(data))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes of data>", len(data))
+	}
+
+	return redactForm(values)
+}
+
+// LogMiddleware logs all requests and responses at the debug level, and
+// failed calls at the error level. The vendor_auth_code, emails and card
+// details are redacted, including in the errors returned by Client.Do.
+func LogMiddleware(logger *slog.Logger) Middleware {
+	return Middleware{
+		BeforeRequest: func(req *http.Request) {
+			logger.LogAttrs(req.Context(), slog.LevelDebug, "paddle request",
+				slog.String("method", req.Method),
+				slog.String("url", sanitizeURL(req.URL).String()),
+				slog.String("body", requestBody(req)))
+		},
+		AfterResponse: func(req *http.Request, resp *http.Response, body []byte, elapsed time.Duration) {
+			logger.LogAttrs(req.Context(), slog.LevelDebug, "paddle response",
+				slog.String("method", req.Method),
+				slog.String("url", sanitizeURL(req.URL).String()),
+				slog.Int("status", resp.StatusCode),
+				slog.Duration("elapsed", elapsed),
+				slog.String("body", redactJSON(body)))
+		},
+		OnError: func(req *http.Request, resp *http.Response, err error) {
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("url", sanitizeURL(req.URL).String()),
+				slog.String("error", err.Error()),
+			}
+			if resp != nil {
+				attrs = append(attrs, slog.Int("status", resp.StatusCode))
+			}
+			logger.LogAttrs(req.Context(), slog.LevelError, "paddle error", attrs...)
+		},
+	}
+}
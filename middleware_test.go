@@ -0,0 +1,88 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	client, mux := setup(t)
+	var calls []string
+	client.middleware = []Middleware{{
+		BeforeRequest: func(req *http.Request) {
+			calls = append(calls, "before")
+			req.Header.Set("X-Foo", "bar")
+		},
+		AfterResponse: func(req *http.Request, resp *http.Response, body []byte, elapsed time.Duration) {
+			calls = append(calls, fmt.Sprintf("after %d", resp.StatusCode))
+		},
+		OnError: func(req *http.Request, resp *http.Response, err error) {
+			calls = append(calls, "error "+err.Error())
+		},
+	}}
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "bar", r.Header.Get("X-Foo"))
+		fmt.Fprint(w, `{"success":false,"error":{"code":102,"message":"Bad api key"}}`)
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	require.Error(t, err)
+	require.Len(t, calls, 3)
+	require.Equal(t, "before", calls[0])
+	require.Equal(t, "after 200", calls[1])
+	require.Contains(t, calls[2], "Bad api key")
+}
+
+func TestLogMiddleware(t *testing.T) {
+	client, mux := setup(t)
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client.middleware = []Middleware{LogMiddleware(logger)}
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":true,"response":[{"subscription_id":1,"user_email":"jan@example.com","payment_information":{"card_type":"visa","last_four_digits":"4242"}}]}`)
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{SubscriptionID: "1"})
+	require.NoError(t, err)
+
+	logs := buf.String()
+	require.Contains(t, logs, "paddle request")
+	require.Contains(t, logs, "subscription_id=1")
+	require.Contains(t, logs, "paddle response")
+	require.Contains(t, logs, `\"subscription_id\":1`)
+	require.NotContains(t, logs, "secret")
+	require.NotContains(t, logs, "jan@example.com")
+	require.NotContains(t, logs, "visa")
+	require.NotContains(t, logs, "4242")
+}
+
+func TestLogMiddlewareError(t *testing.T) {
+	client, mux := setup(t)
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	client.middleware = []Middleware{LogMiddleware(logger)}
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		// An object instead of a list fails to decode.
+		fmt.Fprint(w, `{"success":true,"response":{"subscription_id":1,"user_email":"jan@example.com"}}`)
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	require.Error(t, err)
+
+	logs := buf.String()
+	require.Contains(t, logs, "paddle error")
+	require.Contains(t, logs, `\"subscription_id\":1`)
+	require.NotContains(t, logs, "jan@example.com")
+}
+
+func TestRedactJSON(t *testing.T) {
+	require.Equal(t, `{"a":[{"email":"REDACTED"}],"b":1}`, redactJSON([]byte(`{"a":[{"email":"x@example.com"}],"b":1}`)))
+	require.Equal(t, "<5 bytes of non-JSON data>", redactJSON([]byte("<html")))
+}
@@ -0,0 +1,136 @@
+package paddle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Order states.
+const (
+	OrderStateProcessing = "processing"
+	OrderStateProcessed  = "processed"
+	OrderStateIncomplete = "incomplete"
+)
+
+type OrderCheckout struct {
+	CheckoutID string `json:"checkout_id"`
+	ImageURL   string `json:"image_url"`
+	Title      string `json:"title"`
+}
+
+type OrderCustomer struct {
+	Email            string `json:"email"`
+	MarketingConsent bool   `json:"marketing_consent"`
+}
+
+type Order struct {
+	OrderID                    int           `json:"order_id"`
+	Total                      string        `json:"total"`
+	TotalTax                   string        `json:"total_tax"`
+	Currency                   string        `json:"currency"`
+	FormattedTotal             string        `json:"formatted_total"`
+	FormattedTax               string        `json:"formatted_tax"`
+	CouponCode                 string        `json:"coupon_code"`
+	ReceiptURL                 string        `json:"receipt_url"`
+	CustomerSuccessRedirectURL string        `json:"customer_success_redirect_url"`
+	HasLocker                  bool          `json:"has_locker"`
+	IsSubscription             bool          `json:"is_subscription"`
+	ProductID                  int           `json:"product_id"`
+	SubscriptionID             int           `json:"subscription_id"`
+	SubscriptionOrderID        string        `json:"subscription_order_id"`
+	Quantity                   int           `json:"quantity"`
+	Customer                   OrderCustomer `json:"customer"`
+}
+
+// OrderLocker holds what the customer bought, e.g. a license code.
+type OrderLocker struct {
+	LockerID     int    `json:"locker_id"`
+	ProductID    int    `json:"product_id"`
+	ProductName  string `json:"product_name"`
+	LicenseCode  string `json:"license_code"`
+	Instructions string `json:"instructions"`
+	Download     string `json:"download"`
+}
+
+// OrderInfo is the state of a checkout. Order is nil until the order is
+// processed.
+type OrderInfo struct {
+	Checkout OrderCheckout `json:"checkout"`
+	Order    *Order        `json:"order"`
+	Lockers  []OrderLocker `json:"lockers"`
+	State    string        `json:"state"`
+}
+
+// https://developer.paddle.com/api-reference/checkout-api/order-information/getorder
+type OrderOptions struct {
+	CheckoutID string `url:"checkout_id"`
+}
+
+// Get returns the order made by the checkout. It's served by version 1.0 of
+// the checkout API, which doesn't wrap its responses like the others do.
+func (s *OrderService) Get(ctx context.Context, checkoutID string, opts ...RequestOption) (*OrderInfo, error) {
+	u, err := addOptions("../1.0/order", &OrderOptions{CheckoutID: checkoutID})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewCheckoutRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf, withEndpoint(opts, "order")...)
+	if err != nil {
+		return nil, err
+	}
+
+	var order struct {
+		OrderInfo
+		ErrorField *Error `json:"error"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &order); err != nil {
+		return nil, err
+	}
+	if order.ErrorField != nil {
+		return nil, &ErrorResponse{response: resp, ErrorField: *order.ErrorField}
+	}
+
+	return &order.OrderInfo, nil
+}
+
+// orderPollBackoff sets the intervals at which WaitForCompletion polls.
+var orderPollBackoff = RetryPolicy{
+	MinBackoff: time.Second,
+	MaxBackoff: 10 * time.Second,
+}
+
+// WaitForCompletion polls Get, backing off exponentially, until the order is
+// processed or ctx is done. In the latter case it returns the last OrderInfo
+// it got, if any, along with the error.
+func (s *OrderService) WaitForCompletion(ctx context.Context, checkoutID string, opts ...RequestOption) (*OrderInfo, error) {
+	var info *OrderInfo
+	for attempt := 1; ; attempt++ {
+		wait := orderPollBackoff.backoff(attempt, nil)
+
+		res, err := s.Get(ctx, checkoutID, opts...)
+		var rerr *RateLimitError
+		switch {
+		case errors.As(err, &rerr):
+			wait = time.Until(rerr.Reset)
+		case err != nil:
+			return info, err
+		case res.State == OrderStateProcessed:
+			return res, nil
+		default:
+			info = res
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return info, err
+		}
+	}
+}
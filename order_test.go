@@ -0,0 +1,81 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const processedOrder = `{"checkout":{"checkout_id":"123-abc","image_url":"","title":"App"},
+	"order":{"order_id":1,"total":"10.00","total_tax":"2.00","currency":"USD","formatted_total":"$10.00","formatted_tax":"$2.00","coupon_code":null,"receipt_url":"https://example.com/receipt","customer_success_redirect_url":"","has_locker":true,"is_subscription":false,"product_id":5,"subscription_id":null,"subscription_order_id":null,"quantity":1,"customer":{"email":"jan@example.com","marketing_consent":true}},
+	"lockers":[{"locker_id":1,"product_id":5,"product_name":"App","license_code":"ABC-123","instructions":"","download":""}],
+	"state":"processed"}`
+
+func TestOrderGet(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/1.0/order", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "GET", r.Method)
+		switch r.URL.Query().Get("checkout_id") {
+		case "123-abc":
+			fmt.Fprint(w, processedOrder)
+		default:
+			fmt.Fprint(w, `{"error":{"code":101,"message":"Bad method call"}}`)
+		}
+	})
+
+	info, err := client.Order.Get(context.Background(), "123-abc")
+	require.NoError(t, err)
+	require.Equal(t, OrderStateProcessed, info.State)
+	require.Equal(t, 1, info.Order.OrderID)
+	require.Equal(t, "jan@example.com", info.Order.Customer.Email)
+	require.Equal(t, "ABC-123", info.Lockers[0].LicenseCode)
+
+	_, err = client.Order.Get(context.Background(), "nope")
+	require.ErrorIs(t, err, ErrBadMethodCall)
+}
+
+// fastOrderPolling makes WaitForCompletion poll every millisecond until the
+// end of the test.
+func fastOrderPolling(t *testing.T) {
+	backoff := orderPollBackoff
+	t.Cleanup(func() { orderPollBackoff = backoff })
+	orderPollBackoff = RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+}
+
+func TestOrderWaitForCompletion(t *testing.T) {
+	fastOrderPolling(t)
+	client, mux := setup(t)
+	calls := 0
+	mux.HandleFunc("/1.0/order", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			fmt.Fprint(w, `{"checkout":{"checkout_id":"123-abc"},"state":"processing"}`)
+			return
+		}
+		fmt.Fprint(w, processedOrder)
+	})
+
+	info, err := client.Order.WaitForCompletion(context.Background(), "123-abc")
+	require.NoError(t, err)
+	require.Equal(t, OrderStateProcessed, info.State)
+	require.Equal(t, 3, calls)
+}
+
+func TestOrderWaitForCompletionTimeout(t *testing.T) {
+	fastOrderPolling(t)
+	client, mux := setup(t)
+	mux.HandleFunc("/1.0/order", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"checkout":{"checkout_id":"123-abc"},"state":"processing"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	info, err := client.Order.WaitForCompletion(ctx, "123-abc")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, OrderStateProcessing, info.State)
+	require.Nil(t, info.Order)
+}
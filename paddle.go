@@ -15,14 +15,13 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// Why there are two separate APIs I do not know
-var defaultBaseURL = "https://vendors.paddle.com/api/2.0/"
-var checkoutBaseURL = "https://checkout.paddle.com/api/2.0/"
-
 type Conf struct {
 	VendorID int
 	APIKey   string
@@ -33,6 +32,24 @@ type Conf struct {
 
 	// webhook verification
 	PublicKey *rsa.PublicKey
+
+	// Retry controls how failed calls are retried. DefaultRetryPolicy is used
+	// if nil.
+	Retry *RetryPolicy
+
+	// RateLimit throttles calls on the client side.
+	RateLimit RateLimit
+
+	// Environment selects the Paddle hosts, Production if left empty.
+	Environment Environment
+
+	// Middleware observes all traffic to Paddle, in order.
+	Middleware []Middleware
+
+	// TracerProvider and MeterProvider enable OpenTelemetry instrumentation
+	// of all calls to Paddle. Either may be nil.
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
 }
 
 // Init loads the RSA Public Key from publicKeyPath into Conf.
@@ -62,51 +79,89 @@ func (c *Conf) Init(publicKeyPath string) error {
 	return nil
 }
 
+type AlertService service
+type CouponService service
+type OrderService service
+type PaymentService service
 type ProductService service
 type SubscriptionService service
+type TransactionService service
+type UserService service
 
 type Client struct {
 	client *http.Client
 	conf   *Conf
-	// Base URL for API requests. baseURL should always be specified with a trailing slash.
-	baseURL *url.URL
+	retry  *RetryPolicy
+	// limiter is shared by all services.
+	limiter    *rateLimiter
+	middleware []Middleware
+	telemetry  *telemetry
+	// ctx is the context the Client was created with. Cancelling it aborts
+	// all in-flight calls.
+	ctx context.Context
+	// Base URLs for API requests, one for the vendors API and one for the
+	// checkout API. They should always be specified with a trailing slash.
+	baseURL     *url.URL
+	checkoutURL *url.URL
 
 	// Services used for talking to different parts of the Paddle API.
 	Subscription *SubscriptionService
 	Product      *ProductService
+	Payment      *PaymentService
+	Coupon       *CouponService
+	Transaction  *TransactionService
+	Alert        *AlertService
+	Order        *OrderService
+	User         *UserService
 }
 
 type service struct {
 	client *Client
 }
 
+// NewClient returns a Client for both the vendors and the checkout API. Each
+// service method is routed to the right one. It panics if conf.Environment
+// is invalid; use Environment.Validate to check it beforehand.
 func (conf *Conf) NewClient(ctx context.Context, client *http.Client) *Client {
-	baseURL, _ := url.Parse(defaultBaseURL)
+	baseURL, checkoutURL, err := conf.Environment.baseURLs()
+	if err != nil {
+		panic(err)
+	}
+	retry := conf.Retry
+	if retry == nil {
+		retry = &DefaultRetryPolicy
+	}
 	c := &Client{
-		client:  client,
-		conf:    conf,
-		baseURL: baseURL,
+		client:      client,
+		conf:        conf,
+		retry:       retry,
+		limiter:     newRateLimiter(conf.RateLimit),
+		middleware:  conf.Middleware,
+		telemetry:   newTelemetry(conf.TracerProvider, conf.MeterProvider),
+		ctx:         ctx,
+		baseURL:     baseURL,
+		checkoutURL: checkoutURL,
 	}
 	s := &service{client: c}
 
 	c.Subscription = (*SubscriptionService)(s)
 	c.Product = (*ProductService)(s)
+	c.Payment = (*PaymentService)(s)
+	c.Coupon = (*CouponService)(s)
+	c.Transaction = (*TransactionService)(s)
+	c.Alert = (*AlertService)(s)
+	c.Order = (*OrderService)(s)
+	c.User = (*UserService)(s)
 
 	return c
 }
 
+// NewCheckoutClient returns a Client with an empty Conf, which is enough for
+// the checkout API.
+//
+// Deprecated: Use Conf.NewClient, which talks to the checkout API as well.
 func NewCheckoutClient(ctx context.Context, client *http.Client) *Client {
-	baseURL, _ := url.Parse(checkoutBaseURL)
-	c := &Client{
-		client:  client,
-		baseURL: baseURL,
-	}
-	s := &service{client: c}
-
-	c.Subscription = (*SubscriptionService)(s)
-	c.Product = (*ProductService)(s)
-
-	return c
+	return (&Conf{}).NewClient(ctx, client)
 }
 
 // addOptions adds the parameters in opt as URL query parameters to s. opt
@@ -137,10 +192,17 @@ func addOptions(s string, opt interface{}) (string, error) {
 // specified, the value pointed to by body is JSON encoded and included as the
 // request body.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
-	if !strings.HasSuffix(c.baseURL.Path, "/") {
-		return nil, fmt.Errorf("baseURL must have a trailing slash, but %q does not", c.baseURL)
-	}
-	u, err := c.baseURL.Parse(urlStr)
+	return newRequest(c.baseURL, method, urlStr, body)
+}
+
+// NewCheckoutRequest is like NewRequest, but urlStr is resolved relative to
+// the base URL of the checkout API.
+func (c *Client) NewCheckoutRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	return newRequest(c.checkoutURL, method, urlStr, body)
+}
+
+func newRequest(baseURL *url.URL, method, urlStr string, body interface{}) (*http.Request, error) {
+	u, err := resolveURL(baseURL, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -168,54 +230,263 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
-// Do sends an API request and returns the API response. The API response is
+func resolveURL(baseURL *url.URL, urlStr string) (*url.URL, error) {
+	if !strings.HasSuffix(baseURL.Path, "/") {
+		return nil, fmt.Errorf("baseURL must have a trailing slash, but %q does not", baseURL)
+	}
+
+	return baseURL.Parse(urlStr)
+}
+
+// NewFormRequest creates a POST request to the vendor API. A relative URL
+// should be provided in urlStr, which is resolved relative to the BaseURL of
+// the Client. opt must be a struct whose fields may contain "url" tags, it is
+// form encoded and sent as the request body, so that the vendor credentials
+// never end up in the URL.
+func (c *Client) NewFormRequest(urlStr string, opt interface{}) (*http.Request, error) {
+	u, err := resolveURL(c.baseURL, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	if v := reflect.ValueOf(opt); opt != nil && !(v.Kind() == reflect.Ptr && v.IsNil()) {
+		if form, err = query.Values(opt); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequest("POST", u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req, nil
+}
+
+// Do sends an API request and returns the API response. Transient failures of
+// calls that are safe to repeat are retried according to the RetryPolicy of
+// the Client, see RetryPolicy for details. The API response is
 // JSON decoded and stored in the value pointed to by v, or returned as an
-// error if an API error has occurred. If v implements the io.Writer
+// error if an API error has occurred. Non-2xx responses which don't say what
+// went wrong are returned as *StatusError. If v implements the io.Writer
 // interface, the raw response body will be written to v, without attempting to
-// first decode it. If rate limit is exceeded and reset time is in the future,
+// first decode it or check its "success" field; only the HTTP status is
+// checked. If rate limit is exceeded and reset time is in the future,
 // Do returns *RateLimitError immediately without making a network API call.
+// The limit is either the one configured in Conf.RateLimit, or the one Paddle
+// told us about in a throttling response.
 //
 // The provided ctx must be non-nil. If it is canceled or times out,
-// ctx.Err() will be returned.
-func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+// ctx.Err() will be returned. The same applies to the context the Client was
+// created with.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}, opts ...RequestOption) (*http.Response, error) {
+	cfg := newRequestConfig(opts)
+	endpoint := cfg.endpoint
+	if endpoint == "" {
+		endpoint = c.endpoint(req)
+	}
+
+	start := time.Now()
+	ctx, span := c.telemetry.start(ctx, endpoint, req)
+
+	var attempts int
+	resp, err := c.do(ctx, req, v, cfg, &attempts)
+	var eresp *ErrorResponse
+	if errors.As(err, &eresp) {
+		eresp.endpoint = endpoint
+	}
+	if err != nil {
+		for _, m := range c.middleware {
+			if m.OnError != nil {
+				m.OnError(req, resp, err)
+			}
+		}
+	}
+	c.telemetry.end(ctx, span, endpoint, resp, err, attempts, time.Since(start))
+
+	return resp, err
+}
+
+// do implements Do, storing the number of attempts made in attempts.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}, cfg *requestConfig, attempts *int) (*http.Response, error) {
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.timeout)
+		defer cancel()
+	}
+	ctx, cancel := c.withClientContext(ctx)
+	defer cancel()
+
+	// The headers are added to a copy, so that the request can be reused.
+	req = req.Clone(ctx)
+	for k, values := range cfg.header {
+		for _, value := range values {
+			req.Header.Add(k, value)
+		}
+	}
+
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead
+	if cfg.idempotent != nil {
+		idempotent = *cfg.idempotent
+	}
+
+	var resp *http.Response
+	var data []byte
+	var err error
+	for attempt := 1; ; attempt++ {
+		if reset, ok := c.limiter.take(time.Now()); !ok {
+			if attempt == 1 {
+				return nil, &RateLimitError{Reset: reset}
+			}
+			// The failure which caused the retry is more useful.
+			break
+		}
+		*attempts = attempt
+
+		resp, data, err = c.roundTrip(ctx, req)
+
+		retry := idempotent && attempt < c.retry.MaxAttempts && ctx.Err() == nil && shouldRetry(resp, err)
+		var wait time.Duration
+		if retry {
+			wait = c.retry.backoff(attempt, resp)
+			// Only a Retry-After header can ask for more than MaxBackoff,
+			// in which case the call fails rather than blocking for that
+			// long.
+			if c.retry.MaxBackoff > 0 && wait > c.retry.MaxBackoff {
+				retry, wait = false, 0
+			}
+		}
+		if c.retry.OnAttempt != nil {
+			c.retry.OnAttempt(Attempt{
+				Request:  req,
+				Number:   attempt,
+				Response: resp,
+				Err:      err,
+				Retry:    retry,
+				Wait:     wait,
+			})
+		}
+		if !retry {
+			break
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err != nil {
+		return resp, err
+	}
+
+	if rerr := checkRateLimit(resp); rerr != nil {
+		c.limiter.block(rerr.Reset)
+		return resp, rerr
+	}
+
+	// Some endpoints don't follow the "success" convention, and are decoded
+	// by the caller.
+	if w, ok := v.(io.Writer); ok {
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return resp, &StatusError{Response: resp}
+		}
+		_, err := w.Write(data)
+		return resp, err
+	}
+
+	if err := checkError(resp, data); err != nil {
+		// Failures which aren't reported by Paddle itself, e.g. an HTML
+		// page from a proxy, are only known by their status.
+		var eresp *ErrorResponse
+		if (resp.StatusCode < 200 || resp.StatusCode > 299) &&
+			!(errors.As(err, &eresp) && eresp.ErrorField != (Error{})) {
+			return resp, &StatusError{Response: resp}
+		}
+		return resp, err
+	}
+
+	if v != nil {
+		if err := json.Unmarshal(data, v); err != nil {
+			// The body ends up in logs and traces, see LogMiddleware.
+			return resp, fmt.Errorf("err=%v, data=%v", err, redactJSON(data))
+		}
+	}
+
+	return resp, nil
+}
+
+// roundTrip makes a single attempt at sending req and reads the whole
+// response body.
+func (c *Client) roundTrip(ctx context.Context, req *http.Request) (*http.Response, []byte, error) {
+	// Middleware gets a fresh copy on every attempt, so that the headers it
+	// adds don't pile up.
+	req = req.Clone(ctx)
+	for _, m := range c.middleware {
+		if m.BeforeRequest != nil {
+			m.BeforeRequest(req)
+		}
+	}
+
+	start := time.Now()
 	resp, err := c.client.Do(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
 		select {
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			return nil, nil, ctx.Err()
 		default:
 		}
 
-		// If the error type is *url.Error
+		// If the error type is *url.Error, sanitize its URL before returning.
 		if e, ok := err.(*url.Error); ok {
 			if url, err := url.Parse(e.URL); err == nil {
-				e.URL = url.String()
-				return nil, e
+				e.URL = sanitizeURL(url).String()
+				return nil, nil, e
 			}
 		}
 
-		return nil, err
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	data, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return resp, nil, err
 	}
 
-	if err := checkError(resp, data); err != nil {
-		return resp, err
+	for _, m := range c.middleware {
+		if m.AfterResponse != nil {
+			m.AfterResponse(req, resp, data, time.Since(start))
+		}
 	}
 
-	if v != nil {
-		if err := json.Unmarshal(data, v); err != nil {
-			return resp, fmt.Errorf("err=%v, data=%v", err, string(data))
-		}
+	return resp, data, nil
+}
+
+// withClientContext returns a context which is done when either ctx or the
+// context the Client was created with is done.
+func (c *Client) withClientContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	if c.ctx == nil || c.ctx.Done() == nil {
+		return ctx, cancel
 	}
 
-	return resp, nil
+	go func() {
+		select {
+		case <-c.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
 }
 
 type Error struct {
@@ -228,6 +499,7 @@ type Error struct {
 // something went wrong.
 type ErrorResponse struct {
 	response *http.Response // HTTP response that caused this error
+	endpoint string         // set by Client.Do, see errorMessages
 
 	Success    bool  `json:"success"`
 	ErrorField Error `json:"error"`
@@ -235,12 +507,59 @@ type ErrorResponse struct {
 
 func (r ErrorResponse) Error() string {
 	return fmt.Sprintf("%v %v: StatusCode: %d Code: %d Message: \"%v\" Success: %v)",
-		r.response.Request.Method, r.response.Request.URL,
+		r.response.Request.Method, sanitizeURL(r.response.Request.URL),
 		r.response.StatusCode, r.ErrorField.Code, r.ErrorField.Message, r.Success)
 }
 
+// Unwrap returns the sentinel errors matching the Paddle error code and
+// message, if there are any, so that errors.Is(err, ErrSubscriptionNotFound)
+// works.
+func (r *ErrorResponse) Unwrap() []error {
+	var errs []error
+	if err, ok := errorCodes[r.ErrorField.Code]; ok {
+		errs = append(errs, err)
+	}
+
+	return append(errs, r.messageErrors()...)
+}
+
+// messageErrors returns the sentinel errors matching the message.
+func (r *ErrorResponse) messageErrors() []error {
+	var errs []error
+	for _, m := range errorMessages {
+		if m.match(r.endpoint, r.ErrorField.Message) {
+			errs = append(errs, m.err)
+		}
+	}
+
+	return errs
+}
+
+// StatusError is returned by Client.Do when Paddle responds with a non-2xx
+// status and without saying what went wrong, e.g. when it's down.
+type StatusError struct {
+	Response *http.Response
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%v %v: StatusCode: %d",
+		e.Response.Request.Method, sanitizeURL(e.Response.Request.URL), e.Response.StatusCode)
+}
+
+// sanitizeURL returns a copy of the URL with the vendor_auth_code and other
+// sensitive parameters, e.g. emails, redacted.
+func sanitizeURL(uri *url.URL) *url.URL {
+	if uri == nil {
+		return nil
+	}
+	u := *uri
+	u.RawQuery = redactForm(u.Query())
+
+	return &u
+}
+
 // Every Paddle API response contains a field called "success". If it's not true, then something
-// went wrong.
+// went wrong, and an *ErrorResponse is returned.
 func checkError(r *http.Response, data []byte) error {
 	errorResponse := &ErrorResponse{response: r}
 	if data != nil {
@@ -250,11 +569,7 @@ func checkError(r *http.Response, data []byte) error {
 	}
 
 	if !errorResponse.Success {
-		switch errorResponse.ErrorField.Message {
-		case ErrCountryDoesNotExist.Error():
-			return ErrCountryDoesNotExist
-		}
-		return errors.New(errorResponse.ErrorField.Message)
+		return errorResponse
 	}
 
 	return nil
@@ -1,7 +1,14 @@
 package paddle
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -14,3 +21,172 @@ func TestInit(t *testing.T) {
 	err = c.Init("testdata/invalid.pub")
 	require.Equal(t, err.Error(), "failed to parse PEM block containing the public key")
 }
+
+// setup starts a test HTTP server and returns a Client talking to it.
+func setup(t *testing.T) (*Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	conf := &Conf{
+		VendorID: 1234,
+		APIKey:   "secret",
+		Environment: Environment{
+			VendorsURL:  server.URL + "/",
+			CheckoutURL: server.URL + "/checkout/",
+		},
+	}
+	client := conf.NewClient(context.Background(), server.Client())
+
+	return client, mux
+}
+
+func TestDoRequestOptions(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "key", r.Header.Get("Idempotency-Key"))
+		require.Equal(t, "trace", r.Header.Get("X-Request-Id"))
+		require.Equal(t, "bar", r.Header.Get("X-Foo"))
+		fmt.Fprint(w, `{"success":true,"response":[{"subscription_id":1}]}`)
+	})
+
+	res, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{},
+		WithIdempotencyKey("key"), WithTraceID("trace"), WithHeader("X-Foo", "bar"))
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Response[0].SubscriptionID)
+}
+
+func TestDoReuseRequest(t *testing.T) {
+	client, mux := setup(t)
+	client.retry = &RetryPolicy{MaxAttempts: 2}
+	client.middleware = []Middleware{{
+		BeforeRequest: func(req *http.Request) {
+			req.Header.Add("X-B", "2")
+		},
+	}}
+	calls := 0
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		require.Equal(t, []string{"1"}, r.Header.Values("X-A"))
+		require.Equal(t, []string{"2"}, r.Header.Values("X-B"))
+		if calls%2 == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"success":true,"response":[]}`)
+	})
+
+	req, err := client.NewRequest("GET", "subscription/users", nil)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = client.Do(context.Background(), req, nil, WithHeader("X-A", "1"))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 4, calls)
+	require.Empty(t, req.Header)
+}
+
+func TestDoTimeout(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		<-r.Context().Done()
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{},
+		WithTimeout(10*time.Millisecond))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestDoClientContext(t *testing.T) {
+	client, mux := setup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	client.ctx = ctx
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		io.Copy(io.Discard, r.Body)
+		<-r.Context().Done()
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestNewFormRequest(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/users/update", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Empty(t, r.URL.RawQuery)
+		require.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "1234", r.PostForm.Get("vendor_id"))
+		require.Equal(t, "secret", r.PostForm.Get("vendor_auth_code"))
+		require.Equal(t, "42", r.PostForm.Get("subscription_id"))
+		fmt.Fprint(w, `{"success":false,"error":{"code":119,"message":"Unable to find requested subscription"}}`)
+	})
+
+	_, err := client.Subscription.Update(context.Background(), &SubscriptionUpdateOptions{SubscriptionID: 42})
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "secret")
+}
+
+func TestSanitizeURL(t *testing.T) {
+	u, _ := url.Parse("https://vendors.paddle.com/api/2.0/subscription/users?vendor_auth_code=secret&vendor_id=1")
+	require.Equal(t, "https://vendors.paddle.com/api/2.0/subscription/users?vendor_auth_code=REDACTED&vendor_id=1", sanitizeURL(u).String())
+	u, _ = url.Parse("https://checkout.paddle.com/api/2.0/user/history?email=jan%40example.com&vendor_id=1")
+	require.Equal(t, "https://checkout.paddle.com/api/2.0/user/history?email=REDACTED&vendor_id=1", sanitizeURL(u).String())
+	// The URL of the request must be left alone.
+	require.Equal(t, "jan@example.com", u.Query().Get("email"))
+}
+
+func TestEnvironment(t *testing.T) {
+	conf := &Conf{}
+	client := conf.NewClient(context.Background(), nil)
+	require.Equal(t, Production.VendorsURL, client.baseURL.String())
+	require.Equal(t, Production.CheckoutURL, client.checkoutURL.String())
+
+	conf.Environment = Sandbox
+	client = conf.NewClient(context.Background(), nil)
+	require.Equal(t, "https://sandbox-vendors.paddle.com/api/2.0/", client.baseURL.String())
+	require.Equal(t, "https://sandbox-checkout.paddle.com/api/2.0/", client.checkoutURL.String())
+
+	// A partial environment must not fall back to production for the other
+	// API.
+	conf.Environment = Environment{VendorsURL: "http://staging/"}
+	require.EqualError(t, conf.Environment.Validate(), `paddle: Environment must set both VendorsURL and CheckoutURL, got "http://staging/" and ""`)
+	require.PanicsWithError(t, `paddle: Environment must set both VendorsURL and CheckoutURL, got "http://staging/" and ""`, func() {
+		conf.NewClient(context.Background(), nil)
+	})
+
+	conf.Environment = Environment{VendorsURL: "http://staging/", CheckoutURL: "http://staging:port/"}
+	require.EqualError(t, conf.Environment.Validate(), `paddle: invalid CheckoutURL: parse "http://staging:port/": invalid port ":port" after host`)
+	require.PanicsWithError(t, `paddle: invalid CheckoutURL: parse "http://staging:port/": invalid port ":port" after host`, func() {
+		conf.NewClient(context.Background(), nil)
+	})
+
+	conf.Environment = Environment{VendorsURL: "http://staging/api", CheckoutURL: "http://staging/checkout/"}
+	require.EqualError(t, conf.Environment.Validate(), `paddle: VendorsURL must have a trailing slash, but "http://staging/api" does not`)
+
+	require.NoError(t, Environment{}.Validate())
+	require.NoError(t, Sandbox.Validate())
+}
+
+func TestCheckoutRouting(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/checkout/prices", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "PL", r.URL.Query().Get("customer_country"))
+		fmt.Fprint(w, `{"success":true,"response":{"customer_country":"PL"}}`)
+	})
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":true,"response":[]}`)
+	})
+
+	prices, err := client.Subscription.Prices(context.Background(), SubscriptionPricesOptions{CustomerCountry: "PL"})
+	require.NoError(t, err)
+	require.Equal(t, "PL", prices.Response.CustomerCountry)
+
+	_, err = client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	require.NoError(t, err)
+}
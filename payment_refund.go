@@ -0,0 +1,55 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+// https://developer.paddle.com/api-reference/product-api/payments/refundpayment
+type PaymentRefundOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	OrderID string `url:"order_id"`
+	Amount  string `url:"amount,omitempty"`
+	Reason  string `url:"reason,omitempty"`
+}
+
+type PaymentRefund struct {
+	RefundRequestID int `json:"refund_request_id"`
+}
+
+type PaymentRefundResponse struct {
+	Success  bool          `json:"success"`
+	Response PaymentRefund `json:"response"`
+}
+
+// Refund requests a refund of the order. An amount of 0 refunds the whole
+// order, anything else is a partial refund. It returns an error wrapping
+// ErrAlreadyRefunded or ErrRefundAmountTooHigh if there isn't enough left to
+// refund.
+func (s *PaymentService) Refund(ctx context.Context, orderID string, amount float64, reason string, opts ...RequestOption) (*PaymentRefundResponse, error) {
+	if amount < 0 {
+		return nil, errors.New("refund amount must not be negative")
+	}
+
+	options := &PaymentRefundOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+		OrderID:        orderID,
+		Reason:         reason,
+	}
+	if amount > 0 {
+		options.Amount = strconv.FormatFloat(amount, 'f', -1, 64)
+	}
+	req, err := s.client.NewFormRequest("payment/refund", options)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := new(PaymentRefundResponse)
+	_, err = s.client.Do(ctx, req, refund, opts...)
+
+	return refund, err
+}
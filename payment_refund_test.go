@@ -0,0 +1,41 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefund(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/payment/refund", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "Duplicate", r.PostForm.Get("reason"))
+		switch r.PostForm.Get("order_id") {
+		case "1-1":
+			require.False(t, r.PostForm.Has("amount"))
+			fmt.Fprint(w, `{"success":true,"response":{"refund_request_id":12345}}`)
+		case "1-2":
+			require.Equal(t, "5.5", r.PostForm.Get("amount"))
+			fmt.Fprint(w, `{"success":false,"error":{"message":"Refund amount exceeds the refundable amount"}}`)
+		default:
+			fmt.Fprint(w, `{"success":false,"error":{"message":"Order already refunded."}}`)
+		}
+	})
+
+	res, err := client.Payment.Refund(context.Background(), "1-1", 0, "Duplicate")
+	require.NoError(t, err)
+	require.Equal(t, 12345, res.Response.RefundRequestID)
+
+	_, err = client.Payment.Refund(context.Background(), "1-2", 5.5, "Duplicate")
+	require.ErrorIs(t, err, ErrRefundAmountTooHigh)
+
+	_, err = client.Payment.Refund(context.Background(), "1-3", 0, "Duplicate")
+	require.ErrorIs(t, err, ErrAlreadyRefunded)
+
+	_, err = client.Payment.Refund(context.Background(), "1-3", -1, "Duplicate")
+	require.Error(t, err)
+}
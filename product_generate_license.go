@@ -0,0 +1,49 @@
+package paddle
+
+import (
+	"context"
+	"time"
+)
+
+type ProductLicense struct {
+	LicenseCode string `json:"license_code"`
+	ExpiresAt   string `json:"expires_at"`
+}
+
+type ProductLicenseResponse struct {
+	Success  bool           `json:"success"`
+	Response ProductLicense `json:"response"`
+}
+
+// https://developer.paddle.com/api-reference/product-api/licenses/createlicense
+type ProductGenerateLicenseOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	ProductID   int    `url:"product_id"`
+	AllowedUses int    `url:"allowed_uses"`
+	ExpiresAt   string `url:"expires_at,omitempty"` // YYYY-MM-DD
+}
+
+// GenerateLicense generates a license code for the product, which can be
+// activated allowedUses times. A zero expiresAt means it never expires.
+func (s *ProductService) GenerateLicense(ctx context.Context, productID int, allowedUses int, expiresAt time.Time, opts ...RequestOption) (*ProductLicenseResponse, error) {
+	options := &ProductGenerateLicenseOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+		ProductID:      productID,
+		AllowedUses:    allowedUses,
+	}
+	if !expiresAt.IsZero() {
+		options.ExpiresAt = expiresAt.Format("2006-01-02")
+	}
+	req, err := s.client.NewFormRequest("product/generate_license", options)
+	if err != nil {
+		return nil, err
+	}
+
+	license := new(ProductLicenseResponse)
+	_, err = s.client.Do(ctx, req, license, opts...)
+
+	return license, err
+}
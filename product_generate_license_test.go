@@ -0,0 +1,34 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateLicense(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/product/generate_license", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "5", r.PostForm.Get("product_id"))
+		require.Equal(t, "3", r.PostForm.Get("allowed_uses"))
+		if r.PostForm.Has("expires_at") {
+			require.Equal(t, "2022-01-31", r.PostForm.Get("expires_at"))
+			fmt.Fprint(w, `{"success":true,"response":{"license_code":"ABC-123","expires_at":"2022-01-31"}}`)
+		} else {
+			fmt.Fprint(w, `{"success":true,"response":{"license_code":"DEF-456","expires_at":null}}`)
+		}
+	})
+
+	res, err := client.Product.GenerateLicense(context.Background(), 5, 3, time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, ProductLicense{LicenseCode: "ABC-123", ExpiresAt: "2022-01-31"}, res.Response)
+
+	res, err = client.Product.GenerateLicense(context.Background(), 5, 3, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, ProductLicense{LicenseCode: "DEF-456"}, res.Response)
+}
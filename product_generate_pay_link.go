@@ -48,41 +48,31 @@ type ProductGeneratePayLinkOptions struct {
 	VatPostcode             string   `url:"vat_postcode,omitempty"`
 }
 
-func (s *ProductService) GeneratePayLink(ctx context.Context, options *ProductGeneratePayLinkOptions) (*ProductPayLinkResponse, error) {
+func (s *ProductService) GeneratePayLink(ctx context.Context, options *ProductGeneratePayLinkOptions, opts ...RequestOption) (*ProductPayLinkResponse, error) {
 	options.VendorID = s.client.conf.VendorID
 	options.VendorAuthCode = s.client.conf.APIKey
 	options.ProductID = s.client.conf.ProductID
-	u, err := addOptions("product/generate_pay_link", options)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := s.client.NewRequest("POST", u, nil)
+	req, err := s.client.NewFormRequest("product/generate_pay_link", options)
 	if err != nil {
 		return nil, err
 	}
 
 	paylink := new(ProductPayLinkResponse)
-	_, err = s.client.Do(ctx, req, paylink)
+	_, err = s.client.Do(ctx, req, paylink, opts...)
 
 	return paylink, err
 }
 
-func (s *ProductService) GeneratePayLinkCustom(ctx context.Context, options *ProductGeneratePayLinkOptions) (*ProductPayLinkResponse, error) {
+func (s *ProductService) GeneratePayLinkCustom(ctx context.Context, options *ProductGeneratePayLinkOptions, opts ...RequestOption) (*ProductPayLinkResponse, error) {
 	options.VendorID = s.client.conf.VendorID
 	options.VendorAuthCode = s.client.conf.APIKey
-	u, err := addOptions("product/generate_pay_link", options)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := s.client.NewRequest("POST", u, nil)
+	req, err := s.client.NewFormRequest("product/generate_pay_link", options)
 	if err != nil {
 		return nil, err
 	}
 
 	paylink := new(ProductPayLinkResponse)
-	_, err = s.client.Do(ctx, req, paylink)
+	_, err = s.client.Do(ctx, req, paylink, opts...)
 
 	return paylink, err
 }
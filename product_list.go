@@ -0,0 +1,53 @@
+package paddle
+
+import (
+	"context"
+)
+
+// Product is a one-off product from the catalog. Prices are keyed by currency
+// code.
+type Product struct {
+	ID          int                `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	BasePrice   float64            `json:"base_price"`
+	SalePrice   *float64           `json:"sale_price"`
+	Currency    string             `json:"currency"`
+	Screenshots []string           `json:"screenshots"`
+	Icon        string             `json:"icon"`
+	Prices      map[string]float64 `json:"prices"`
+}
+
+type ProductList struct {
+	Total    int       `json:"total"`
+	Count    int       `json:"count"`
+	Products []Product `json:"products"`
+}
+
+type ProductListResponse struct {
+	Success  bool        `json:"success"`
+	Response ProductList `json:"response"`
+}
+
+// https://developer.paddle.com/api-reference/product-api/products/getproducts
+type ProductListOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+}
+
+// List lists all one-off products in the catalog.
+func (s *ProductService) List(ctx context.Context, opts ...RequestOption) (*ProductListResponse, error) {
+	options := &ProductListOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+	}
+	req, err := s.client.NewFormRequest("product/get_products", options)
+	if err != nil {
+		return nil, err
+	}
+
+	products := new(ProductListResponse)
+	_, err = s.client.Do(ctx, req, products, safeToRetry(opts)...)
+
+	return products, err
+}
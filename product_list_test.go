@@ -0,0 +1,33 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductList(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/product/get_products", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "1234", r.PostForm.Get("vendor_id"))
+		fmt.Fprint(w, `{"success":true,"response":{"total":1,"count":1,"products":[{"id":5,"name":"App","description":"An app","base_price":58,"sale_price":null,"currency":"USD","screenshots":["https://example.com/1.png"],"icon":"https://example.com/icon.png","prices":{"USD":58,"EUR":52.5}}]}}`)
+	})
+
+	res, err := client.Product.List(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Response.Total)
+	require.Equal(t, []Product{{
+		ID:          5,
+		Name:        "App",
+		Description: "An app",
+		BasePrice:   58,
+		Currency:    "USD",
+		Screenshots: []string{"https://example.com/1.png"},
+		Icon:        "https://example.com/icon.png",
+		Prices:      map[string]float64{"USD": 58, "EUR": 52.5},
+	}}, res.Response.Products)
+}
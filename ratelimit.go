@@ -0,0 +1,116 @@
+package paddle
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimit configures the client-side token bucket shared by all services of
+// a Client. The zero value disables it, but throttling responses from Paddle
+// are still honoured.
+type RateLimit struct {
+	// Rate is the sustained number of requests per second.
+	Rate float64
+	// Burst is the number of requests that can be made at once. Values below
+	// 1 are treated as 1.
+	Burst int
+}
+
+// throttleWait is used when Paddle throttles us without saying for how long.
+const throttleWait = time.Minute
+
+// RateLimitError is returned by Client.Do when the rate limit is exceeded,
+// either the client-side one or Paddle's.
+type RateLimitError struct {
+	// Reset is the earliest time at which the next call can be made.
+	Reset time.Time
+	// Response is the throttling response from Paddle, or nil if the call
+	// was stopped by the client-side limiter.
+	Response *http.Response
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %v", e.Reset.Format(time.RFC3339))
+}
+
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	// until is set when Paddle throttles us.
+	until time.Time
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		rate:   limit.Rate,
+		burst:  burst,
+		tokens: burst,
+	}
+}
+
+// take consumes a token. If none is available it returns false and the time
+// at which one will be.
+func (l *rateLimiter) take(now time.Time) (time.Time, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.until) {
+		return l.until, false
+	}
+	if l.rate <= 0 {
+		return time.Time{}, true
+	}
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+
+	if l.tokens < 1 {
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		return now.Add(wait), false
+	}
+	l.tokens--
+
+	return time.Time{}, true
+}
+
+// block stops all calls until the given time.
+func (l *rateLimiter) block(until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until.After(l.until) {
+		l.until = until
+	}
+}
+
+// checkRateLimit detects throttling responses from Paddle.
+func checkRateLimit(resp *http.Response) *RateLimitError {
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return nil
+	}
+
+	wait, ok := retryAfter(resp.Header.Get("Retry-After"))
+	if !ok {
+		wait = throttleWait
+	}
+
+	return &RateLimitError{
+		Reset:    time.Now().Add(wait),
+		Response: resp,
+	}
+}
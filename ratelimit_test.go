@@ -0,0 +1,90 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(RateLimit{Rate: 2, Burst: 2})
+	now := time.Now()
+
+	_, ok := l.take(now)
+	require.True(t, ok)
+	_, ok = l.take(now)
+	require.True(t, ok)
+	reset, ok := l.take(now)
+	require.False(t, ok)
+	require.Equal(t, now.Add(500*time.Millisecond), reset)
+
+	_, ok = l.take(now.Add(500 * time.Millisecond))
+	require.True(t, ok)
+}
+
+func TestDoClientSideRateLimit(t *testing.T) {
+	client, mux := setup(t)
+	client.limiter = newRateLimiter(RateLimit{Rate: 0.1, Burst: 1})
+	calls := 0
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"success":true,"response":[]}`)
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	require.NoError(t, err)
+
+	// The limiter is shared with the other services.
+	_, err = client.Product.GeneratePayLink(context.Background(), &ProductGeneratePayLinkOptions{})
+	var rerr *RateLimitError
+	require.True(t, errors.As(err, &rerr))
+	require.Nil(t, rerr.Response)
+	require.True(t, rerr.Reset.After(time.Now()))
+	require.Equal(t, 1, calls)
+}
+
+func TestDoClientSideRateLimitRetry(t *testing.T) {
+	client, mux := setup(t)
+	client.limiter = newRateLimiter(RateLimit{Rate: 0.1, Burst: 1})
+	client.retry = &RetryPolicy{MaxAttempts: 3}
+	calls := 0
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	// The retry is refused by the limiter, but the caller gets the failure
+	// which caused it.
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	var serr *StatusError
+	require.ErrorAs(t, err, &serr)
+	require.Equal(t, http.StatusBadGateway, serr.Response.StatusCode)
+	require.Equal(t, 1, calls)
+}
+
+func TestDoThrottled(t *testing.T) {
+	client, mux := setup(t)
+	calls := 0
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	var rerr *RateLimitError
+	require.True(t, errors.As(err, &rerr))
+	require.Equal(t, http.StatusTooManyRequests, rerr.Response.StatusCode)
+	require.WithinDuration(t, time.Now().Add(30*time.Second), rerr.Reset, time.Second)
+
+	// Following calls fail without reaching Paddle.
+	_, err = client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	require.True(t, errors.As(err, &rerr))
+	require.Nil(t, rerr.Response)
+	require.Equal(t, 1, calls)
+}
@@ -0,0 +1,75 @@
+package paddle
+
+import (
+	"net/http"
+	"time"
+)
+
+// RequestOption customises a single API call. Every service method accepts a
+// variadic list of them, e.g.
+//
+//	client.Subscription.Users(ctx, opts, paddle.WithTimeout(2*time.Second))
+type RequestOption func(*requestConfig)
+
+type requestConfig struct {
+	timeout    time.Duration
+	header     http.Header
+	idempotent *bool
+	endpoint   string
+}
+
+func newRequestConfig(opts []RequestOption) *requestConfig {
+	cfg := &requestConfig{header: make(http.Header)}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithTimeout bounds the whole call, including reading the response body. It
+// applies on top of any deadline already set on the context.
+func WithTimeout(d time.Duration) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.timeout = d
+	}
+}
+
+// WithHeader adds an extra HTTP header to the request.
+func WithHeader(key, value string) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.header.Add(key, value)
+	}
+}
+
+// WithIdempotencyKey tags the request with an Idempotency-Key header.
+func WithIdempotencyKey(key string) RequestOption {
+	return WithHeader("Idempotency-Key", key)
+}
+
+// WithTraceID tags the request with an X-Request-Id header, so that it can be
+// correlated with our own logs.
+func WithTraceID(id string) RequestOption {
+	return WithHeader("X-Request-Id", id)
+}
+
+// WithIdempotent overrides whether the call is safe to retry, see RetryPolicy.
+func WithIdempotent(idempotent bool) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.idempotent = &idempotent
+	}
+}
+
+// safeToRetry marks a call to a read-only endpoint as idempotent, unless the
+// caller says otherwise.
+func safeToRetry(opts []RequestOption) []RequestOption {
+	return append([]RequestOption{WithIdempotent(true)}, opts...)
+}
+
+// withEndpoint names the endpoint of a call in traces and metrics, for
+// endpoints with IDs in the path, e.g. "subscription/{subscription_id}/charge".
+func withEndpoint(opts []RequestOption, endpoint string) []RequestOption {
+	return append([]RequestOption{func(cfg *requestConfig) {
+		cfg.endpoint = endpoint
+	}}, opts...)
+}
@@ -0,0 +1,116 @@
+package paddle
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how Client.Do retries calls which failed with a
+// connection error or a 5xx response. Only calls that are safe to repeat are
+// retried: GET and HEAD requests, calls to read-only endpoints, and calls
+// marked with WithIdempotent(true).
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// The wait before the n-th retry is MinBackoff*2^(n-1), capped at
+	// MaxBackoff, with jitter applied. A Retry-After header sent by Paddle
+	// takes precedence, but if it asks for more than MaxBackoff the call is
+	// not retried.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// OnAttempt, if set, is called after every attempt.
+	OnAttempt func(Attempt)
+}
+
+// Attempt describes a single attempt made by Client.Do.
+type Attempt struct {
+	Request  *http.Request
+	Number   int            // starting at 1
+	Response *http.Response // nil if the request failed before getting a response
+	Err      error
+	Retry    bool          // whether another attempt will be made
+	Wait     time.Duration // how long Client.Do waits before the next attempt
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  10 * time.Second,
+}
+
+// NoRetries disables retries.
+var NoRetries = RetryPolicy{MaxAttempts: 1}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode >= 500
+}
+
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+
+	wait := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	// Equal jitter: half of the wait is fixed, the other half is random.
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,121 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoRetries(t *testing.T) {
+	client, mux := setup(t)
+	var attempts []Attempt
+	client.retry = &RetryPolicy{
+		MaxAttempts: 3,
+		OnAttempt:   func(a Attempt) { attempts = append(attempts, a) },
+	}
+	calls := 0
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"success":true,"response":[]}`)
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+	require.Len(t, attempts, 2)
+	require.True(t, attempts[0].Retry)
+	require.Equal(t, http.StatusServiceUnavailable, attempts[0].Response.StatusCode)
+	require.False(t, attempts[1].Retry)
+}
+
+func TestDoRetriesExhausted(t *testing.T) {
+	client, mux := setup(t)
+	client.retry = &RetryPolicy{MaxAttempts: 3}
+	calls := 0
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	mux.HandleFunc("/subscription/plans", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, "<html>Service Unavailable</html>")
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	var serr *StatusError
+	require.ErrorAs(t, err, &serr)
+	require.Equal(t, http.StatusBadGateway, serr.Response.StatusCode)
+	require.Contains(t, err.Error(), "POST")
+	require.Contains(t, err.Error(), "/subscription/users: StatusCode: 502")
+	require.Equal(t, 3, calls)
+
+	_, err = client.Subscription.Plans(context.Background(), &SubscriptionPlansOptions{})
+	require.ErrorAs(t, err, &serr)
+	require.Equal(t, http.StatusServiceUnavailable, serr.Response.StatusCode)
+}
+
+func TestDoRetryAfterTooLong(t *testing.T) {
+	client, mux := setup(t)
+	client.retry = &RetryPolicy{MaxAttempts: 3, MaxBackoff: time.Second}
+	calls := 0
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	var serr *StatusError
+	require.ErrorAs(t, err, &serr)
+	require.Equal(t, "3600", serr.Response.Header.Get("Retry-After"))
+	require.Equal(t, 1, calls)
+}
+
+func TestDoNoRetryNonIdempotent(t *testing.T) {
+	client, mux := setup(t)
+	client.retry = &RetryPolicy{MaxAttempts: 3}
+	calls := 0
+	mux.HandleFunc("/subscription/users/update", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.Subscription.Update(context.Background(), &SubscriptionUpdateOptions{})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+
+	calls = 0
+	_, err = client.Subscription.Update(context.Background(), &SubscriptionUpdateOptions{}, WithIdempotent(true))
+	require.Error(t, err)
+	require.Equal(t, 3, calls)
+}
+
+func TestRetryAfter(t *testing.T) {
+	wait, ok := retryAfter("3")
+	require.True(t, ok)
+	require.Equal(t, 3*time.Second, wait)
+
+	wait, ok = retryAfter(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	require.True(t, ok)
+	require.Zero(t, wait)
+
+	_, ok = retryAfter("soon")
+	require.False(t, ok)
+}
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 3 * time.Second}
+	for attempt := 1; attempt < 70; attempt++ {
+		wait := p.backoff(attempt, nil)
+		require.LessOrEqual(t, wait, 3*time.Second)
+		require.GreaterOrEqual(t, wait, 500*time.Millisecond)
+	}
+}
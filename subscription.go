@@ -1,7 +1,13 @@
 package paddle
 
 type Payment struct {
-	Amount   float64 `json:"amount"`
-	Currency string  `json:"currency"`
-	Date     string  `json:"date"`
+	ID             int     `json:"id"`
+	SubscriptionID int     `json:"subscription_id"`
+	Amount         float64 `json:"amount"`
+	Currency       string  `json:"currency"`
+	Date           string  `json:"date"`
+	PayoutDate     string  `json:"payout_date"`
+	IsPaid         int     `json:"is_paid"`
+	IsOneOffCharge bool    `json:"is_one_off_charge"`
+	ReceiptURL     string  `json:"receipt_url"`
 }
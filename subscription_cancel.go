@@ -0,0 +1,37 @@
+package paddle
+
+import (
+	"context"
+)
+
+// https://developer.paddle.com/api-reference/subscription-api/users/cancelsubscription
+type SubscriptionCancelOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	SubscriptionID int `url:"subscription_id"`
+}
+
+type SubscriptionCancelResponse struct {
+	Success bool `json:"success"`
+}
+
+// Cancel cancels the subscription immediately. It returns an error wrapping
+// ErrSubscriptionNotFound or ErrSubscriptionAlreadyCancelled if there is
+// nothing to cancel.
+func (s *SubscriptionService) Cancel(ctx context.Context, subscriptionID int, opts ...RequestOption) (*SubscriptionCancelResponse, error) {
+	options := &SubscriptionCancelOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+		SubscriptionID: subscriptionID,
+	}
+	req, err := s.client.NewFormRequest("subscription/users_cancel", options)
+	if err != nil {
+		return nil, err
+	}
+
+	cancellation := new(SubscriptionCancelResponse)
+	_, err = s.client.Do(ctx, req, cancellation, opts...)
+
+	return cancellation, err
+}
@@ -0,0 +1,35 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancel(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/users_cancel", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		switch r.PostForm.Get("subscription_id") {
+		case "1":
+			fmt.Fprint(w, `{"success":true}`)
+		case "2":
+			fmt.Fprint(w, `{"success":false,"error":{"code":119,"message":"Unable to find requested subscription"}}`)
+		default:
+			fmt.Fprint(w, `{"success":false,"error":{"message":"Subscription is already canceled."}}`)
+		}
+	})
+
+	res, err := client.Subscription.Cancel(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+
+	_, err = client.Subscription.Cancel(context.Background(), 2)
+	require.ErrorIs(t, err, ErrSubscriptionNotFound)
+
+	_, err = client.Subscription.Cancel(context.Background(), 3)
+	require.ErrorIs(t, err, ErrSubscriptionAlreadyCancelled)
+}
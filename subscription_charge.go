@@ -0,0 +1,67 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// maxChargeNameLength is the longest charge name Paddle accepts.
+const maxChargeNameLength = 50
+
+var ErrInvalidChargeName = fmt.Errorf("charge name must be 1 to %d characters long", maxChargeNameLength)
+
+// https://developer.paddle.com/api-reference/subscription-api/one-off-charges/createcharge
+type SubscriptionChargeOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	Amount     string `url:"amount"`
+	ChargeName string `url:"charge_name"`
+}
+
+type SubscriptionCharge struct {
+	InvoiceID      int    `json:"invoice_id"`
+	SubscriptionID int    `json:"subscription_id"`
+	Amount         string `json:"amount"`
+	Currency       string `json:"currency"`
+	PaymentDate    string `json:"payment_date"`
+	ReceiptURL     string `json:"receipt_url"`
+	OrderID        string `json:"order_id"`
+	Status         string `json:"status"` // success or pending
+}
+
+type SubscriptionChargeResponse struct {
+	Success  bool               `json:"success"`
+	Response SubscriptionCharge `json:"response"`
+}
+
+// Charge makes a one-off charge against the subscription, in its currency.
+// chargeName appears on the invoice and must be at most 50 characters long,
+// ErrInvalidChargeName is returned otherwise.
+func (s *SubscriptionService) Charge(ctx context.Context, subscriptionID int, amount float64, chargeName string, opts ...RequestOption) (*SubscriptionChargeResponse, error) {
+	if n := utf8.RuneCountInString(chargeName); n == 0 || n > maxChargeNameLength {
+		return nil, ErrInvalidChargeName
+	}
+	if amount <= 0 {
+		return nil, errors.New("charge amount must be positive")
+	}
+
+	options := &SubscriptionChargeOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+		Amount:         strconv.FormatFloat(amount, 'f', -1, 64),
+		ChargeName:     chargeName,
+	}
+	req, err := s.client.NewFormRequest(fmt.Sprintf("subscription/%d/charge", subscriptionID), options)
+	if err != nil {
+		return nil, err
+	}
+
+	charge := new(SubscriptionChargeResponse)
+	_, err = s.client.Do(ctx, req, charge, withEndpoint(opts, "subscription/{subscription_id}/charge")...)
+
+	return charge, err
+}
@@ -0,0 +1,46 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestCharge(t *testing.T) {
+	client, mux := setup(t)
+	spans := tracetest.NewSpanRecorder()
+	client.telemetry = newTelemetry(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)), nil)
+	mux.HandleFunc("/subscription/42/charge", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "9.99", r.PostForm.Get("amount"))
+		require.Equal(t, "Credit pack", r.PostForm.Get("charge_name"))
+		fmt.Fprint(w, `{"success":true,"response":{"invoice_id":1,"subscription_id":42,"amount":"9.99","currency":"USD","payment_date":"2021-05-12","receipt_url":"https://example.com/receipt","order_id":"1-2","status":"success"}}`)
+	})
+
+	res, err := client.Subscription.Charge(context.Background(), 42, 9.99, "Credit pack")
+	require.NoError(t, err)
+	require.Equal(t, SubscriptionCharge{
+		InvoiceID:      1,
+		SubscriptionID: 42,
+		Amount:         "9.99",
+		Currency:       "USD",
+		PaymentDate:    "2021-05-12",
+		ReceiptURL:     "https://example.com/receipt",
+		OrderID:        "1-2",
+		Status:         "success",
+	}, res.Response)
+	require.Equal(t, "paddle subscription/{subscription_id}/charge", spans.Ended()[0].Name())
+
+	_, err = client.Subscription.Charge(context.Background(), 42, 9.99, strings.Repeat("x", 51))
+	require.ErrorIs(t, err, ErrInvalidChargeName)
+	_, err = client.Subscription.Charge(context.Background(), 42, 9.99, "")
+	require.ErrorIs(t, err, ErrInvalidChargeName)
+	_, err = client.Subscription.Charge(context.Background(), 42, 0, "Credit pack")
+	require.Error(t, err)
+}
@@ -180,7 +180,15 @@ func ValidatePayload(r *http.Request, pubkey *rsa.PublicKey) (interface{}, error
 		return nil, err
 	}
 
-	switch r.Form.Get("alert_name") {
+	return DecodeAlert(r.Form.Get("alert_name"), payload)
+}
+
+// DecodeAlert decodes the fields of an alert into the typed struct matching
+// alertName, e.g. *SubscriptionCreated. It returns nil for unknown alerts.
+// The fields must come from a trusted source: a webhook verified by
+// ValidatePayload, or AlertService.History.
+func DecodeAlert(alertName string, payload map[string]string) (interface{}, error) {
+	switch alertName {
 	case "subscription_created":
 		var ret SubscriptionCreated
 		if j, err := json.Marshal(payload); err != nil {
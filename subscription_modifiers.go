@@ -0,0 +1,107 @@
+package paddle
+
+import (
+	"context"
+)
+
+// Modifiers add a surcharge or a discount to the payments of a subscription.
+type Modifier struct {
+	ModifierID     int    `json:"modifier_id"`
+	SubscriptionID int    `json:"subscription_id"`
+	Amount         string `json:"amount"`
+	Currency       string `json:"currency"`
+	IsRecurring    bool   `json:"is_recurring"`
+	Description    string `json:"description"`
+}
+
+// https://developer.paddle.com/api-reference/subscription-api/modifiers/createmodifier
+type SubscriptionCreateModifierOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	SubscriptionID      int    `url:"subscription_id,omitempty"` // required
+	ModifierAmount      string `url:"modifier_amount,omitempty"` // required, negative for a discount
+	ModifierRecurring   *bool  `url:"modifier_recurring,omitempty"`
+	ModifierDescription string `url:"modifier_description,omitempty"`
+}
+
+type SubscriptionCreateModifier struct {
+	SubscriptionID int `json:"subscription_id"`
+	ModifierID     int `json:"modifier_id"`
+}
+
+type SubscriptionCreateModifierResponse struct {
+	Success  bool                       `json:"success"`
+	Response SubscriptionCreateModifier `json:"response"`
+}
+
+func (s *SubscriptionService) CreateModifier(ctx context.Context, options *SubscriptionCreateModifierOptions, opts ...RequestOption) (*SubscriptionCreateModifierResponse, error) {
+	options.VendorID = s.client.conf.VendorID
+	options.VendorAuthCode = s.client.conf.APIKey
+	req, err := s.client.NewFormRequest("subscription/modifiers/create", options)
+	if err != nil {
+		return nil, err
+	}
+
+	modifier := new(SubscriptionCreateModifierResponse)
+	_, err = s.client.Do(ctx, req, modifier, opts...)
+
+	return modifier, err
+}
+
+// https://developer.paddle.com/api-reference/subscription-api/modifiers/listmodifiers
+type SubscriptionModifiersOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	SubscriptionID int `url:"subscription_id,omitempty"`
+	PlanID         int `url:"plan_id,omitempty"`
+}
+
+type SubscriptionModifiersResponse struct {
+	Success  bool       `json:"success"`
+	Response []Modifier `json:"response"`
+}
+
+func (s *SubscriptionService) Modifiers(ctx context.Context, options *SubscriptionModifiersOptions, opts ...RequestOption) (*SubscriptionModifiersResponse, error) {
+	options.VendorID = s.client.conf.VendorID
+	options.VendorAuthCode = s.client.conf.APIKey
+	req, err := s.client.NewFormRequest("subscription/modifiers", options)
+	if err != nil {
+		return nil, err
+	}
+
+	modifiers := new(SubscriptionModifiersResponse)
+	_, err = s.client.Do(ctx, req, modifiers, safeToRetry(opts)...)
+
+	return modifiers, err
+}
+
+// https://developer.paddle.com/api-reference/subscription-api/modifiers/deletemodifier
+type SubscriptionDeleteModifierOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	ModifierID int `url:"modifier_id"`
+}
+
+type SubscriptionDeleteModifierResponse struct {
+	Success bool `json:"success"`
+}
+
+func (s *SubscriptionService) DeleteModifier(ctx context.Context, modifierID int, opts ...RequestOption) (*SubscriptionDeleteModifierResponse, error) {
+	options := &SubscriptionDeleteModifierOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+		ModifierID:     modifierID,
+	}
+	req, err := s.client.NewFormRequest("subscription/modifiers/delete", options)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion := new(SubscriptionDeleteModifierResponse)
+	_, err = s.client.Do(ctx, req, deletion, opts...)
+
+	return deletion, err
+}
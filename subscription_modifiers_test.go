@@ -0,0 +1,53 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifiers(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/modifiers/create", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "42", r.PostForm.Get("subscription_id"))
+		require.Equal(t, "-5.00", r.PostForm.Get("modifier_amount"))
+		require.Equal(t, "false", r.PostForm.Get("modifier_recurring"))
+		fmt.Fprint(w, `{"success":true,"response":{"subscription_id":42,"modifier_id":7}}`)
+	})
+	mux.HandleFunc("/subscription/modifiers", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":true,"response":[{"modifier_id":7,"subscription_id":42,"amount":"-5.00","currency":"USD","is_recurring":false,"description":"Discount"}]}`)
+	})
+	mux.HandleFunc("/subscription/modifiers/delete", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "7", r.PostForm.Get("modifier_id"))
+		fmt.Fprint(w, `{"success":true}`)
+	})
+
+	recurring := false
+	created, err := client.Subscription.CreateModifier(context.Background(), &SubscriptionCreateModifierOptions{
+		SubscriptionID:      42,
+		ModifierAmount:      "-5.00",
+		ModifierRecurring:   &recurring,
+		ModifierDescription: "Discount",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 7, created.Response.ModifierID)
+
+	modifiers, err := client.Subscription.Modifiers(context.Background(), &SubscriptionModifiersOptions{SubscriptionID: 42})
+	require.NoError(t, err)
+	require.Equal(t, []Modifier{{
+		ModifierID:     7,
+		SubscriptionID: 42,
+		Amount:         "-5.00",
+		Currency:       "USD",
+		Description:    "Discount",
+	}}, modifiers.Response)
+
+	deleted, err := client.Subscription.DeleteModifier(context.Background(), 7)
+	require.NoError(t, err)
+	require.True(t, deleted.Success)
+}
@@ -0,0 +1,72 @@
+package paddle
+
+import (
+	"context"
+	"time"
+)
+
+// https://developer.paddle.com/api-reference/subscription-api/payments/listpayments
+type SubscriptionPaymentsOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	SubscriptionID int    `url:"subscription_id,omitempty"`
+	Plan           int    `url:"plan,omitempty"`
+	IsPaid         *bool  `url:"is_paid,omitempty,int"`
+	From           string `url:"from,omitempty"` // YYYY-MM-DD
+	To             string `url:"to,omitempty"`   // YYYY-MM-DD
+	IsOneOffCharge *bool  `url:"is_one_off_charge,omitempty,int"`
+}
+
+type SubscriptionPaymentsResponse struct {
+	Success  bool      `json:"success"`
+	Response []Payment `json:"response"`
+}
+
+// Payments lists past and upcoming payments. Upcoming payments have a
+// PayoutDate in the future and IsPaid set to 0.
+func (s *SubscriptionService) Payments(ctx context.Context, options *SubscriptionPaymentsOptions, opts ...RequestOption) (*SubscriptionPaymentsResponse, error) {
+	options.VendorID = s.client.conf.VendorID
+	options.VendorAuthCode = s.client.conf.APIKey
+	req, err := s.client.NewFormRequest("subscription/payments", options)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := new(SubscriptionPaymentsResponse)
+	_, err = s.client.Do(ctx, req, payments, safeToRetry(opts)...)
+
+	return payments, err
+}
+
+// https://developer.paddle.com/api-reference/subscription-api/payments/updatepayment
+type SubscriptionReschedulePaymentOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	PaymentID int    `url:"payment_id"`
+	Date      string `url:"date"` // YYYY-MM-DD
+}
+
+type SubscriptionReschedulePaymentResponse struct {
+	Success bool `json:"success"`
+}
+
+// ReschedulePayment moves the date of an upcoming payment.
+func (s *SubscriptionService) ReschedulePayment(ctx context.Context, paymentID int, date time.Time, opts ...RequestOption) (*SubscriptionReschedulePaymentResponse, error) {
+	options := &SubscriptionReschedulePaymentOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+		PaymentID:      paymentID,
+		Date:           date.Format("2006-01-02"),
+	}
+	req, err := s.client.NewFormRequest("subscription/payments_reschedule", options)
+	if err != nil {
+		return nil, err
+	}
+
+	reschedule := new(SubscriptionReschedulePaymentResponse)
+	_, err = s.client.Do(ctx, req, reschedule, opts...)
+
+	return reschedule, err
+}
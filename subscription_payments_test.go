@@ -0,0 +1,48 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayments(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/payments", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "42", r.PostForm.Get("subscription_id"))
+		require.Equal(t, "0", r.PostForm.Get("is_paid"))
+		require.Equal(t, "2021-05-01", r.PostForm.Get("from"))
+		require.Empty(t, r.PostForm.Get("is_one_off_charge"))
+		fmt.Fprint(w, `{"success":true,"response":[{"id":8936,"subscription_id":42,"amount":8,"currency":"USD","payout_date":"2021-06-01","is_paid":0,"is_one_off_charge":false,"receipt_url":""}]}`)
+	})
+	mux.HandleFunc("/subscription/payments_reschedule", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "8936", r.PostForm.Get("payment_id"))
+		require.Equal(t, "2021-06-15", r.PostForm.Get("date"))
+		fmt.Fprint(w, `{"success":true}`)
+	})
+
+	paid := false
+	payments, err := client.Subscription.Payments(context.Background(), &SubscriptionPaymentsOptions{
+		SubscriptionID: 42,
+		IsPaid:         &paid,
+		From:           "2021-05-01",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Payment{{
+		ID:             8936,
+		SubscriptionID: 42,
+		Amount:         8,
+		Currency:       "USD",
+		PayoutDate:     "2021-06-01",
+	}}, payments.Response)
+
+	res, err := client.Subscription.ReschedulePayment(context.Background(), 8936, time.Date(2021, 6, 15, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, res.Success)
+}
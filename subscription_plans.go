@@ -0,0 +1,88 @@
+package paddle
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Plan is a subscription plan. Its ID is what SubscriptionUser.PlanID and
+// the plan_id parameters refer to. Prices are keyed by currency code.
+type Plan struct {
+	ID             int                    `json:"id"`
+	Name           string                 `json:"name"`
+	BillingType    string                 `json:"billing_type"` // day, week, month or year
+	BillingPeriod  int                    `json:"billing_period"`
+	InitialPrice   map[string]json.Number `json:"initial_price"`
+	RecurringPrice map[string]json.Number `json:"recurring_price"`
+	TrialDays      int                    `json:"trial_days"`
+}
+
+// https://developer.paddle.com/api-reference/subscription-api/plans/listplans
+type SubscriptionPlansOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	Plan int `url:"plan,omitempty"`
+}
+
+type SubscriptionPlansResponse struct {
+	Success  bool   `json:"success"`
+	Response []Plan `json:"response"`
+}
+
+func (s *SubscriptionService) Plans(ctx context.Context, options *SubscriptionPlansOptions, opts ...RequestOption) (*SubscriptionPlansResponse, error) {
+	options.VendorID = s.client.conf.VendorID
+	options.VendorAuthCode = s.client.conf.APIKey
+	req, err := s.client.NewFormRequest("subscription/plans", options)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := new(SubscriptionPlansResponse)
+	_, err = s.client.Do(ctx, req, plans, safeToRetry(opts)...)
+
+	return plans, err
+}
+
+// https://developer.paddle.com/api-reference/subscription-api/plans/createplan
+//
+// Paddle only accepts recurring prices here, and only in USD, GBP and EUR.
+// Initial prices default to the recurring ones, and can be overridden on pay
+// links.
+type SubscriptionCreatePlanOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	PlanName          string `url:"plan_name,omitempty"`   // required
+	PlanType          string `url:"plan_type,omitempty"`   // required: day, week, month or year
+	PlanLength        int    `url:"plan_length,omitempty"` // required
+	PlanTrialDays     int    `url:"plan_trial_days,omitempty"`
+	MainCurrencyCode  string `url:"main_currency_code,omitempty"` // USD, GBP or EUR
+	RecurringPriceUSD string `url:"recurring_price_usd,omitempty"`
+	RecurringPriceGBP string `url:"recurring_price_gbp,omitempty"`
+	RecurringPriceEUR string `url:"recurring_price_eur,omitempty"`
+}
+
+type SubscriptionCreatePlan struct {
+	// ProductID is the ID of the new plan.
+	ProductID int `json:"product_id"`
+}
+
+type SubscriptionCreatePlanResponse struct {
+	Success  bool                   `json:"success"`
+	Response SubscriptionCreatePlan `json:"response"`
+}
+
+func (s *SubscriptionService) CreatePlan(ctx context.Context, options *SubscriptionCreatePlanOptions, opts ...RequestOption) (*SubscriptionCreatePlanResponse, error) {
+	options.VendorID = s.client.conf.VendorID
+	options.VendorAuthCode = s.client.conf.APIKey
+	req, err := s.client.NewFormRequest("subscription/plans_create", options)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := new(SubscriptionCreatePlanResponse)
+	_, err = s.client.Do(ctx, req, plan, opts...)
+
+	return plan, err
+}
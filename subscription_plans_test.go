@@ -0,0 +1,50 @@
+package paddle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlans(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/plans", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "9", r.PostForm.Get("plan"))
+		fmt.Fprint(w, `{"success":true,"response":[{"id":9,"name":"Monthly","billing_type":"month","billing_period":1,"initial_price":{"USD":"0.00"},"recurring_price":{"USD":10,"EUR":"9.50"},"trial_days":14}]}`)
+	})
+	mux.HandleFunc("/subscription/plans_create", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "Yearly", r.PostForm.Get("plan_name"))
+		require.Equal(t, "year", r.PostForm.Get("plan_type"))
+		require.Equal(t, "1", r.PostForm.Get("plan_length"))
+		require.Equal(t, "100.00", r.PostForm.Get("recurring_price_usd"))
+		fmt.Fprint(w, `{"success":true,"response":{"product_id":10}}`)
+	})
+
+	plans, err := client.Subscription.Plans(context.Background(), &SubscriptionPlansOptions{Plan: 9})
+	require.NoError(t, err)
+	require.Equal(t, []Plan{{
+		ID:             9,
+		Name:           "Monthly",
+		BillingType:    "month",
+		BillingPeriod:  1,
+		InitialPrice:   map[string]json.Number{"USD": "0.00"},
+		RecurringPrice: map[string]json.Number{"USD": "10", "EUR": "9.50"},
+		TrialDays:      14,
+	}}, plans.Response)
+
+	plan, err := client.Subscription.CreatePlan(context.Background(), &SubscriptionCreatePlanOptions{
+		PlanName:          "Yearly",
+		PlanType:          "year",
+		PlanLength:        1,
+		MainCurrencyCode:  "USD",
+		RecurringPriceUSD: "100.00",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 10, plan.Response.ProductID)
+}
@@ -0,0 +1,42 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+)
+
+type SubscriptionPreviewUpdate struct {
+	SubscriptionID   int     `json:"subscription_id"`
+	PlanID           int     `json:"plan_id"`
+	UserID           int     `json:"user_id"`
+	ImmediatePayment Payment `json:"immediate_payment"`
+	NextPayment      Payment `json:"next_payment"`
+}
+
+type SubscriptionPreviewUpdateResponse struct {
+	Success  bool                      `json:"success"`
+	Response SubscriptionPreviewUpdate `json:"response"`
+}
+
+// PreviewUpdate returns what Update would charge, without applying the
+// changes. It takes the same options as Update, except for Pause, which is
+// an error.
+//
+// https://developer.paddle.com/api-reference/subscription-api/users/previewupdatesubscription
+func (s *SubscriptionService) PreviewUpdate(ctx context.Context, options *SubscriptionUpdateOptions, opts ...RequestOption) (*SubscriptionPreviewUpdateResponse, error) {
+	if options.Pause != nil {
+		return nil, errors.New("pausing can't be previewed")
+	}
+
+	options.VendorID = s.client.conf.VendorID
+	options.VendorAuthCode = s.client.conf.APIKey
+	req, err := s.client.NewFormRequest("subscription/preview_update", options)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := new(SubscriptionPreviewUpdateResponse)
+	_, err = s.client.Do(ctx, req, preview, safeToRetry(opts)...)
+
+	return preview, err
+}
@@ -2,11 +2,8 @@ package paddle
 
 import (
 	"context"
-	"errors"
 )
 
-var ErrCountryDoesNotExist = errors.New("Country does not exist")
-
 type Price struct {
 	Gross float64 `json:"gross"`
 	Net   float64 `json:"net"`
@@ -47,18 +44,19 @@ type SubscriptionPricesOptions struct {
 	Coupons         string `url:"coupons,omitempty"`
 }
 
-func (s *SubscriptionService) Prices(ctx context.Context, options SubscriptionPricesOptions) (*SubscriptionPricesResponse, error) {
+// Prices is served by the checkout API.
+func (s *SubscriptionService) Prices(ctx context.Context, options SubscriptionPricesOptions, opts ...RequestOption) (*SubscriptionPricesResponse, error) {
 	u, err := addOptions("prices", options)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewCheckoutRequest("GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	prices := new(SubscriptionPricesResponse)
-	_, err = s.client.Do(ctx, req, prices)
+	_, err = s.client.Do(ctx, req, prices, opts...)
 	return prices, err
 }
@@ -12,7 +12,7 @@ import (
 func TestPrices(t *testing.T) {
 	t.Skip("Provide your own data")
 
-	client := NewCheckoutClient(context.Background(), &http.Client{})
+	client := (&Conf{}).NewClient(context.Background(), &http.Client{})
 	res, err := client.Subscription.Prices(context.Background(), SubscriptionPricesOptions{
 		CustomerCountry: "PL",
 		ProductIDs:      "1234,2345",
@@ -2,6 +2,7 @@ package paddle
 
 import (
 	"context"
+	"time"
 )
 
 type SubscriptionUpdateOptions struct {
@@ -17,6 +18,7 @@ type SubscriptionUpdateOptions struct {
 	PlanID          int    `url:"plan_id,omitempty"`
 	Prorate         bool   `url:"prorate,omitempty"`
 	KeepModifiers   bool   `url:"keep_modifiers,omitempty"`
+	Pause           *bool  `url:"pause,omitempty"`
 }
 
 type SubscriptionUpdate struct {
@@ -24,6 +26,21 @@ type SubscriptionUpdate struct {
 	PlanID         int     `json:"plan_id"`
 	UserID         int     `json:"user_id"`
 	NextPayment    Payment `json:"next_payment"`
+	PausedAt       string  `json:"paused_at"`
+	PausedFrom     string  `json:"paused_from"`
+	PausedReason   string  `json:"paused_reason"`
+}
+
+// GetPausedFrom returns the date from which a paused subscription is paused,
+// or the zero time if it isn't.
+func (s *SubscriptionUpdate) GetPausedFrom() time.Time {
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s.PausedFrom); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
 }
 
 type SubscriptionUpdateResponse struct {
@@ -31,21 +48,35 @@ type SubscriptionUpdateResponse struct {
 	Response SubscriptionUpdate `json:"response"`
 }
 
-func (s *SubscriptionService) Update(ctx context.Context, options *SubscriptionUpdateOptions) (*SubscriptionUpdateResponse, error) {
+func (s *SubscriptionService) Update(ctx context.Context, options *SubscriptionUpdateOptions, opts ...RequestOption) (*SubscriptionUpdateResponse, error) {
 	options.VendorID = s.client.conf.VendorID
 	options.VendorAuthCode = s.client.conf.APIKey
-	u, err := addOptions("subscription/users/update", options)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewFormRequest("subscription/users/update", options)
 	if err != nil {
 		return nil, err
 	}
 
 	update := new(SubscriptionUpdateResponse)
-	_, err = s.client.Do(ctx, req, update)
+	_, err = s.client.Do(ctx, req, update, opts...)
 
 	return update, err
 }
+
+// Pause pauses the subscription at the end of the current billing period. Use
+// Response.GetPausedFrom on the result to get the effective pause date.
+func (s *SubscriptionService) Pause(ctx context.Context, subscriptionID int, opts ...RequestOption) (*SubscriptionUpdateResponse, error) {
+	pause := true
+	return s.Update(ctx, &SubscriptionUpdateOptions{
+		SubscriptionID: subscriptionID,
+		Pause:          &pause,
+	}, opts...)
+}
+
+// Resume resumes a paused subscription.
+func (s *SubscriptionService) Resume(ctx context.Context, subscriptionID int, opts ...RequestOption) (*SubscriptionUpdateResponse, error) {
+	pause := false
+	return s.Update(ctx, &SubscriptionUpdateOptions{
+		SubscriptionID: subscriptionID,
+		Pause:          &pause,
+	}, opts...)
+}
@@ -0,0 +1,63 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPauseResume(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/users/update", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "42", r.PostForm.Get("subscription_id"))
+		if r.PostForm.Get("pause") == "true" {
+			fmt.Fprint(w, `{"success":true,"response":{"subscription_id":42,"paused_at":"2021-04-20 10:56:08","paused_from":"2021-05-01 00:00:00","paused_reason":"voluntary"}}`)
+		} else {
+			require.Equal(t, "false", r.PostForm.Get("pause"))
+			fmt.Fprint(w, `{"success":true,"response":{"subscription_id":42,"next_payment":{"amount":10,"currency":"USD","date":"2021-06-01"}}}`)
+		}
+	})
+
+	res, err := client.Subscription.Pause(context.Background(), 42)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC), res.Response.GetPausedFrom())
+
+	res, err = client.Subscription.Resume(context.Background(), 42)
+	require.NoError(t, err)
+	require.True(t, res.Response.GetPausedFrom().IsZero())
+	require.Equal(t, "2021-06-01", res.Response.NextPayment.Date)
+}
+
+func TestPreviewUpdate(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/preview_update", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "42", r.PostForm.Get("subscription_id"))
+		require.Equal(t, "5", r.PostForm.Get("quantity"))
+		require.Equal(t, "true", r.PostForm.Get("prorate"))
+		require.Equal(t, "true", r.PostForm.Get("bill_immediately"))
+		fmt.Fprint(w, `{"success":true,"response":{"subscription_id":42,"plan_id":9,"user_id":7,"immediate_payment":{"amount":12.5,"currency":"USD","date":"2021-05-12"},"next_payment":{"amount":50,"currency":"USD","date":"2021-06-01"}}}`)
+	})
+
+	res, err := client.Subscription.PreviewUpdate(context.Background(), &SubscriptionUpdateOptions{
+		SubscriptionID:  42,
+		Quantity:        5,
+		Prorate:         true,
+		BillImmediately: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, Payment{Amount: 12.5, Currency: "USD", Date: "2021-05-12"}, res.Response.ImmediatePayment)
+	require.Equal(t, Payment{Amount: 50, Currency: "USD", Date: "2021-06-01"}, res.Response.NextPayment)
+
+	pause := true
+	_, err = client.Subscription.PreviewUpdate(context.Background(), &SubscriptionUpdateOptions{
+		SubscriptionID: 42,
+		Pause:          &pause,
+	})
+	require.EqualError(t, err, "pausing can't be previewed")
+}
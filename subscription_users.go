@@ -2,6 +2,9 @@ package paddle
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"time"
 )
 
 // https://paddle.com/docs/api-list-users/
@@ -36,21 +39,115 @@ type SubscriptionUsersOptions struct {
 	Page           string `url:"page,omitempty"`
 }
 
-func (s *SubscriptionService) Users(ctx context.Context, options *SubscriptionUsersOptions) (*SubscriptionUsersResponse, error) {
+func (s *SubscriptionService) Users(ctx context.Context, options *SubscriptionUsersOptions, opts ...RequestOption) (*SubscriptionUsersResponse, error) {
 	options.VendorID = s.client.conf.VendorID
 	options.VendorAuthCode = s.client.conf.APIKey
-	u, err := addOptions("subscription/users", options)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewFormRequest("subscription/users", options)
 	if err != nil {
 		return nil, err
 	}
 
 	users := new(SubscriptionUsersResponse)
-	_, err = s.client.Do(ctx, req, users)
+	_, err = s.client.Do(ctx, req, users, safeToRetry(opts)...)
 
 	return users, err
 }
+
+// maxUsersPerPage is the largest ResultsPerPage Paddle accepts.
+const maxUsersPerPage = 200
+
+// SubscriptionUsersIterator walks all pages of Users lazily:
+//
+//	it := client.Subscription.UsersIter(&paddle.SubscriptionUsersOptions{State: "active"})
+//	for it.Next(ctx) {
+//		user := it.User()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type SubscriptionUsersIterator struct {
+	service *SubscriptionService
+	options SubscriptionUsersOptions
+	opts    []RequestOption
+
+	perPage int
+	page    int
+	users   []SubscriptionUser
+	i       int
+	last    bool
+	err     error
+}
+
+// UsersIter returns an iterator over all subscription users matching options.
+// Page and ResultsPerPage, if set, select the first page and the page size,
+// which defaults to the maximum of 200.
+func (s *SubscriptionService) UsersIter(options *SubscriptionUsersOptions, opts ...RequestOption) *SubscriptionUsersIterator {
+	it := &SubscriptionUsersIterator{
+		service: s,
+		options: *options,
+		opts:    opts,
+		perPage: maxUsersPerPage,
+		page:    1,
+	}
+	if n, err := strconv.Atoi(options.ResultsPerPage); err == nil && n > 0 && n < maxUsersPerPage {
+		it.perPage = n
+	}
+	if n, err := strconv.Atoi(options.Page); err == nil && n > 0 {
+		it.page = n
+	}
+	it.options.ResultsPerPage = strconv.Itoa(it.perPage)
+
+	return it
+}
+
+// Next advances to the next user, fetching the next page if needed. It
+// returns false when there are no more users, or on error. When the rate
+// limit is exceeded Next waits for it to reset, unless ctx is done first.
+func (it *SubscriptionUsersIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	if it.i+1 < len(it.users) {
+		it.i++
+		return true
+	}
+	if it.last {
+		return false
+	}
+
+	for {
+		it.options.Page = strconv.Itoa(it.page)
+		res, err := it.service.Users(ctx, &it.options, it.opts...)
+		var rerr *RateLimitError
+		if errors.As(err, &rerr) {
+			if err := sleep(ctx, time.Until(rerr.Reset)); err != nil {
+				it.err = err
+				return false
+			}
+			continue
+		}
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.page++
+		it.users = res.Response
+		it.i = 0
+		// A short page is the last one.
+		it.last = len(it.users) < it.perPage
+
+		return len(it.users) > 0
+	}
+}
+
+// User returns the current user.
+func (it *SubscriptionUsersIterator) User() SubscriptionUser {
+	return it.users[it.i]
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *SubscriptionUsersIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,64 @@
+package paddle
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsersIter(t *testing.T) {
+	client, mux := setup(t)
+	var pages []string
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "2", r.PostForm.Get("results_per_page"))
+		require.Equal(t, "active", r.PostForm.Get("state"))
+		page, _ := strconv.Atoi(r.PostForm.Get("page"))
+		pages = append(pages, r.PostForm.Get("page"))
+
+		// Five users, two per page.
+		res := SubscriptionUsersResponse{Success: true}
+		for id := page*2 - 1; id <= page*2 && id <= 5; id++ {
+			res.Response = append(res.Response, SubscriptionUser{SubscriptionID: id})
+		}
+		json.NewEncoder(w).Encode(res)
+	})
+
+	it := client.Subscription.UsersIter(&SubscriptionUsersOptions{State: "active", ResultsPerPage: "2"})
+	var ids []int
+	for it.Next(context.Background()) {
+		ids = append(ids, it.User().SubscriptionID)
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+	require.Equal(t, []string{"1", "2", "3"}, pages)
+}
+
+func TestUsersIterError(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":false,"error":{"code":102,"message":"Bad api key"}}`))
+	})
+
+	it := client.Subscription.UsersIter(&SubscriptionUsersOptions{})
+	require.False(t, it.Next(context.Background()))
+	require.ErrorIs(t, it.Err(), ErrBadAPIKey)
+}
+
+func TestUsersIterCancelled(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client.middleware = []Middleware{{OnError: func(*http.Request, *http.Response, error) { cancel() }}}
+	it := client.Subscription.UsersIter(&SubscriptionUsersOptions{})
+	require.False(t, it.Next(ctx))
+	require.ErrorIs(t, it.Err(), context.Canceled)
+}
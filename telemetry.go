@@ -0,0 +1,113 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/akfaew/go-paddle"
+
+// telemetry instruments Client.Do with OpenTelemetry. A nil *telemetry does
+// nothing.
+type telemetry struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+func newTelemetry(tp trace.TracerProvider, mp metric.MeterProvider) *telemetry {
+	if tp == nil && mp == nil {
+		return nil
+	}
+
+	t := &telemetry{}
+	if tp != nil {
+		t.tracer = tp.Tracer(instrumentationName)
+	}
+	if mp != nil {
+		meter := mp.Meter(instrumentationName)
+		// The instruments are never nil, errors only report invalid names.
+		t.duration, _ = meter.Float64Histogram("paddle.client.duration",
+			metric.WithDescription("Duration of calls to the Paddle API, retries included."),
+			metric.WithUnit("s"))
+		t.errors, _ = meter.Int64Counter("paddle.client.errors",
+			metric.WithDescription("Number of failed calls to the Paddle API."))
+	}
+
+	return t
+}
+
+func (t *telemetry) start(ctx context.Context, endpoint string, req *http.Request) (context.Context, trace.Span) {
+	if t == nil || t.tracer == nil {
+		return ctx, nil
+	}
+
+	return t.tracer.Start(ctx, "paddle "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("paddle.endpoint", endpoint),
+			attribute.String("http.request.method", req.Method),
+		))
+}
+
+func (t *telemetry) end(ctx context.Context, span trace.Span, endpoint string, resp *http.Response, err error, attempts int, elapsed time.Duration) {
+	if t == nil {
+		return
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("paddle.endpoint", endpoint),
+	}
+	if resp != nil {
+		attrs = append(attrs, attribute.Int("http.response.status_code", resp.StatusCode))
+	}
+	var eresp *ErrorResponse
+	if errors.As(err, &eresp) {
+		attrs = append(attrs, attribute.Int("paddle.error_code", eresp.ErrorField.Code))
+	}
+
+	if t.duration != nil {
+		t.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+		if err != nil {
+			t.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+	}
+
+	if span != nil {
+		span.SetAttributes(attrs...)
+		if attempts > 1 {
+			span.SetAttributes(attribute.Int("paddle.retry_count", attempts-1))
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// endpoint returns the API endpoint of req relative to the base URL it was
+// made against, e.g. "subscription/users". Endpoints with IDs in the path
+// set a template with withEndpoint instead.
+func (c *Client) endpoint(req *http.Request) string {
+	bases := []*url.URL{c.baseURL, c.checkoutURL}
+	if len(c.checkoutURL.Path) > len(c.baseURL.Path) {
+		bases[0], bases[1] = bases[1], bases[0]
+	}
+	for _, base := range bases {
+		if base.Host == req.URL.Host && strings.HasPrefix(req.URL.Path, base.Path) {
+			return strings.TrimPrefix(req.URL.Path, base.Path)
+		}
+	}
+
+	return req.URL.Path
+}
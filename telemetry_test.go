@@ -0,0 +1,92 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTelemetry(t *testing.T) {
+	client, mux := setup(t)
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	client.telemetry = newTelemetry(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	client.retry = &RetryPolicy{MaxAttempts: 2}
+	calls := 0
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"success":false,"error":{"code":119,"message":"Unable to find requested subscription"}}`)
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	require.ErrorIs(t, err, ErrSubscriptionNotFound)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, "paddle subscription/users", ended[0].Name())
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range ended[0].Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	require.Equal(t, "subscription/users", attrs["paddle.endpoint"].AsString())
+	require.Equal(t, int64(200), attrs["http.response.status_code"].AsInt64())
+	require.Equal(t, int64(119), attrs["paddle.error_code"].AsInt64())
+	require.Equal(t, int64(1), attrs["paddle.retry_count"].AsInt64())
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	names := []string{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		names = append(names, m.Name)
+	}
+	require.ElementsMatch(t, []string{"paddle.client.duration", "paddle.client.errors"}, names)
+}
+
+func TestTelemetryRedaction(t *testing.T) {
+	client, mux := setup(t)
+	spans := tracetest.NewSpanRecorder()
+	client.telemetry = newTelemetry(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)), nil)
+	mux.HandleFunc("/subscription/users", func(w http.ResponseWriter, r *http.Request) {
+		// An object instead of a list fails to decode.
+		fmt.Fprint(w, `{"success":true,"response":{"user_email":"jan@example.com"}}`)
+	})
+
+	_, err := client.Subscription.Users(context.Background(), &SubscriptionUsersOptions{})
+	require.Error(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, codes.Error, ended[0].Status().Code)
+	require.NotContains(t, ended[0].Status().Description, "jan@example.com")
+	require.Len(t, ended[0].Events(), 1)
+	for _, kv := range ended[0].Events()[0].Attributes {
+		require.NotContains(t, kv.Value.Emit(), "jan@example.com")
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	client, _ := setup(t)
+
+	req, err := client.NewCheckoutRequest("GET", "prices", nil)
+	require.NoError(t, err)
+	require.Equal(t, "prices", client.endpoint(req))
+
+	req, err = client.NewFormRequest("product/generate_pay_link", nil)
+	require.NoError(t, err)
+	require.Equal(t, "product/generate_pay_link", client.endpoint(req))
+}
@@ -0,0 +1,73 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// TransactionEntity selects what Transaction.List looks transactions up by.
+type TransactionEntity string
+
+const (
+	TransactionEntityUser         TransactionEntity = "user"
+	TransactionEntitySubscription TransactionEntity = "subscription"
+	TransactionEntityOrder        TransactionEntity = "order"
+	TransactionEntityCheckout     TransactionEntity = "checkout"
+	TransactionEntityProduct      TransactionEntity = "product"
+)
+
+type Transaction struct {
+	OrderID        string `json:"order_id"`
+	CheckoutID     string `json:"checkout_id"`
+	Amount         string `json:"amount"`
+	Currency       string `json:"currency"`
+	Status         string `json:"status"`
+	CreatedAt      string `json:"created_at"`
+	Passthrough    string `json:"passthrough"`
+	ProductID      int    `json:"product_id"`
+	IsSubscription bool   `json:"is_subscription"`
+	IsOneOff       bool   `json:"is_one_off"`
+	ReceiptURL     string `json:"receipt_url"`
+}
+
+type TransactionListResponse struct {
+	Success  bool          `json:"success"`
+	Response []Transaction `json:"response"`
+}
+
+// https://developer.paddle.com/api-reference/product-api/transactions/listtransactions
+type TransactionListOptions struct {
+	VendorID       int    `url:"vendor_id"`
+	VendorAuthCode string `url:"vendor_auth_code"`
+
+	Page int `url:"page,omitempty"`
+}
+
+// List lists the transactions of the user, subscription, order, checkout or
+// product with the given id, 15 per page. Pages start at 1, a short page is
+// the last one.
+func (s *TransactionService) List(ctx context.Context, entity TransactionEntity, id string, page int, opts ...RequestOption) (*TransactionListResponse, error) {
+	switch entity {
+	case TransactionEntityUser, TransactionEntitySubscription, TransactionEntityOrder,
+		TransactionEntityCheckout, TransactionEntityProduct:
+	default:
+		return nil, fmt.Errorf("unknown transaction entity %q", entity)
+	}
+
+	options := &TransactionListOptions{
+		VendorID:       s.client.conf.VendorID,
+		VendorAuthCode: s.client.conf.APIKey,
+		Page:           page,
+	}
+	req, err := s.client.NewFormRequest(fmt.Sprintf("%s/%s/transactions", entity, url.PathEscape(id)), options)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := new(TransactionListResponse)
+	opts = withEndpoint(safeToRetry(opts), fmt.Sprintf("%s/{id}/transactions", entity))
+	_, err = s.client.Do(ctx, req, transactions, opts...)
+
+	return transactions, err
+}
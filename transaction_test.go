@@ -0,0 +1,36 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionList(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/order/1-2/transactions", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "2", r.PostForm.Get("page"))
+		fmt.Fprint(w, `{"success":true,"response":[{"order_id":"1-2","checkout_id":"3-abc","amount":"5.00","currency":"USD","status":"completed","created_at":"2021-05-12 10:00:00","passthrough":"","product_id":5,"is_subscription":false,"is_one_off":true,"receipt_url":"https://example.com/receipt"}]}`)
+	})
+
+	res, err := client.Transaction.List(context.Background(), TransactionEntityOrder, "1-2", 2)
+	require.NoError(t, err)
+	require.Equal(t, []Transaction{{
+		OrderID:    "1-2",
+		CheckoutID: "3-abc",
+		Amount:     "5.00",
+		Currency:   "USD",
+		Status:     "completed",
+		CreatedAt:  "2021-05-12 10:00:00",
+		ProductID:  5,
+		IsOneOff:   true,
+		ReceiptURL: "https://example.com/receipt",
+	}}, res.Response)
+
+	_, err = client.Transaction.List(context.Background(), "invoice", "1", 1)
+	require.Error(t, err)
+}
@@ -0,0 +1,45 @@
+package paddle
+
+import (
+	"context"
+	"errors"
+)
+
+// https://developer.paddle.com/api-reference/checkout-api/user/getuserhistory
+type UserHistoryOptions struct {
+	Email     string `url:"email"`
+	VendorID  int    `url:"vendor_id,omitempty"`
+	ProductID int    `url:"product_id,omitempty"`
+}
+
+type UserHistoryResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// History emails the customer their past purchases and license codes,
+// optionally only those from a vendor or of a product. It returns an error
+// wrapping ErrUserNotFound if Paddle doesn't know the email address.
+func (s *UserService) History(ctx context.Context, options *UserHistoryOptions, opts ...RequestOption) (*UserHistoryResponse, error) {
+	if options.Email == "" {
+		return nil, errors.New("email is required")
+	}
+
+	u, err := addOptions("user/history", options)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewCheckoutRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	history := new(UserHistoryResponse)
+	// Sent as GET, but every call sends an email, so it must not be retried
+	// unless the caller says otherwise.
+	opts = append([]RequestOption{WithIdempotent(false)}, opts...)
+	_, err = s.client.Do(ctx, req, history, opts...)
+
+	return history, err
+}
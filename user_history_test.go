@@ -0,0 +1,49 @@
+package paddle
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHistory(t *testing.T) {
+	client, mux := setup(t)
+	client.retry = &RetryPolicy{MaxAttempts: 3}
+	calls := 0
+	mux.HandleFunc("/checkout/user/history", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		require.Equal(t, "GET", r.Method)
+		q := r.URL.Query()
+		require.Equal(t, "5", q.Get("product_id"))
+		require.False(t, q.Has("vendor_id"))
+		switch q.Get("email") {
+		case "jan@example.com":
+			fmt.Fprint(w, `{"success":true,"message":"We've sent details of your past transactions, licenses and downloads to you via email."}`)
+		case "down@example.com":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			fmt.Fprint(w, `{"success":false,"error":{"code":107,"message":"We were unable to find a user with that email address."}}`)
+		}
+	})
+
+	res, err := client.User.History(context.Background(), &UserHistoryOptions{Email: "jan@example.com", ProductID: 5})
+	require.NoError(t, err)
+	require.Contains(t, res.Message, "via email")
+
+	_, err = client.User.History(context.Background(), &UserHistoryOptions{Email: "nobody@example.com", ProductID: 5})
+	require.ErrorIs(t, err, ErrUserNotFound)
+	require.False(t, IsAuthError(err))
+	require.NotContains(t, err.Error(), "nobody")
+
+	// Every call sends an email, so failures aren't retried.
+	calls = 0
+	_, err = client.User.History(context.Background(), &UserHistoryOptions{Email: "down@example.com", ProductID: 5})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+
+	_, err = client.User.History(context.Background(), &UserHistoryOptions{})
+	require.Error(t, err)
+}